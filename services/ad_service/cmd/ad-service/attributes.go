@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func attributeDefsToPb(defs []model.AttributeDef) []*adpb.AttributeDef {
+	res := make([]*adpb.AttributeDef, 0, len(defs))
+	for _, d := range defs {
+		res = append(res, &adpb.AttributeDef{
+			Key:        d.Key,
+			Name:       d.Name,
+			Type:       d.Type,
+			Required:   d.Required,
+			Options:    d.Options,
+			CategoryId: d.CategoryID,
+		})
+	}
+	return res
+}
+
+func attributeFiltersFromPb(filters []*adpb.AttributeFilter) []model.AttributeFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+	res := make([]model.AttributeFilter, 0, len(filters))
+	for _, f := range filters {
+		res = append(res, model.AttributeFilter{Key: f.Key, Equals: f.Equals, Min: f.Min, Max: f.Max})
+	}
+	return res
+}
+
+func (s *adServer) GetCategoryAttributes(ctx context.Context, req *adpb.GetCategoryAttributesRequest) (*adpb.GetCategoryAttributesResponse, error) {
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+	defs, err := s.svc.GetCategoryAttributes(ctx, req.CategoryId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.GetCategoryAttributesResponse{Attributes: attributeDefsToPb(defs)}, nil
+}
+
+func (s *adServer) SetCategoryAttributes(ctx context.Context, req *adpb.SetCategoryAttributesRequest) (*adpb.SetCategoryAttributesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+	defs := make([]model.AttributeDef, 0, len(req.Attributes))
+	for _, a := range req.Attributes {
+		defs = append(defs, model.AttributeDef{Key: a.Key, Name: a.Name, Type: a.Type, Required: a.Required, Options: a.Options})
+	}
+	defs, err := s.svc.SetCategoryAttributes(ctx, req.UserId, req.CategoryId, defs)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.SetCategoryAttributesResponse{Attributes: attributeDefsToPb(defs)}, nil
+}
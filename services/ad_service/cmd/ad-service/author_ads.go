@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"78-pflops/services/ad_service/internal/repository"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *adServer) ListAdsByAuthor(ctx context.Context, req *adpb.ListAdsByAuthorRequest) (*adpb.ListAdsResponse, error) {
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	res, err := s.svc.ListAdsByAuthor(ctx, req.AuthorId, req.ViewerId, statusFromPb(req.Status), limit, offset, req.PageToken)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	respAds := make([]*adpb.Ad, 0, len(res.Ads))
+	for i := range res.Ads {
+		respAds = append(respAds, toPb(&res.Ads[i]))
+	}
+	return &adpb.ListAdsResponse{
+		Ads:            respAds,
+		Total:          int32(res.Total),
+		Page:           int32(page),
+		PageSize:       int32(limit),
+		NextPageToken:  res.NextPageToken,
+		TotalEstimated: res.TotalEstimated,
+	}, nil
+}
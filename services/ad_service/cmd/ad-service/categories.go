@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// helper: convert category (with children) to protobuf
+func categoryToPb(c *model.Category) *adpb.Category {
+	var parentID string
+	if c.ParentID != nil {
+		parentID = *c.ParentID
+	}
+	var lifetime int32
+	if c.AdLifetimeDays != nil {
+		lifetime = int32(*c.AdLifetimeDays)
+	}
+	children := make([]*adpb.Category, 0, len(c.Children))
+	for i := range c.Children {
+		children = append(children, categoryToPb(&c.Children[i]))
+	}
+	return &adpb.Category{
+		Id:             c.ID,
+		Slug:           c.Slug,
+		Name:           c.Name,
+		ParentId:       parentID,
+		Archived:       c.Archived,
+		Children:       children,
+		AdLifetimeDays: lifetime,
+	}
+}
+
+// categoryErr maps category validation errors to gRPC status codes.
+func categoryErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnknownCategory),
+		errors.Is(err, service.ErrArchivedCategory),
+		errors.Is(err, service.ErrInvalidCategory),
+		errors.Is(err, service.ErrCategoryCycle),
+		errors.Is(err, service.ErrInvalidLifetime):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, repository.ErrCategorySlugExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return err
+}
+
+func (s *adServer) ListCategories(ctx context.Context, req *adpb.ListCategoriesRequest) (*adpb.ListCategoriesResponse, error) {
+	tree, err := s.svc.ListCategories(ctx, req.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adpb.ListCategoriesResponse{Categories: make([]*adpb.Category, 0, len(tree))}
+	for i := range tree {
+		resp.Categories = append(resp.Categories, categoryToPb(&tree[i]))
+	}
+	return resp, nil
+}
+
+func (s *adServer) CreateCategory(ctx context.Context, req *adpb.CreateCategoryRequest) (*adpb.CreateCategoryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	c, err := s.svc.CreateCategory(ctx, req.UserId, req.Slug, req.Name, req.ParentId, int(req.AdLifetimeDays))
+	if err != nil {
+		return nil, categoryErr(err)
+	}
+	return &adpb.CreateCategoryResponse{Category: categoryToPb(c)}, nil
+}
+
+func (s *adServer) UpdateCategory(ctx context.Context, req *adpb.UpdateCategoryRequest) (*adpb.UpdateCategoryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	var slugPtr, namePtr, parentPtr *string
+	if req.Slug != nil {
+		v := req.Slug.Value
+		slugPtr = &v
+	}
+	if req.Name != nil {
+		v := req.Name.Value
+		namePtr = &v
+	}
+	if req.ParentId != nil {
+		v := req.ParentId.Value
+		parentPtr = &v
+	}
+	var lifetimePtr *int
+	if req.AdLifetimeDays != nil {
+		v := int(req.AdLifetimeDays.Value)
+		lifetimePtr = &v
+	}
+	c, err := s.svc.UpdateCategory(ctx, req.UserId, req.Id, slugPtr, namePtr, parentPtr, lifetimePtr)
+	if err != nil {
+		return nil, categoryErr(err)
+	}
+	return &adpb.UpdateCategoryResponse{Category: categoryToPb(c)}, nil
+}
+
+func (s *adServer) ArchiveCategory(ctx context.Context, req *adpb.ArchiveCategoryRequest) (*adpb.ArchiveCategoryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.svc.ArchiveCategory(ctx, req.UserId, req.Id); err != nil {
+		return nil, categoryErr(err)
+	}
+	return &adpb.ArchiveCategoryResponse{}, nil
+}
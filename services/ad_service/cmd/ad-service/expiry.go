@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expiryBatch — сколько объявлений архивируется за одну транзакцию.
+const expiryBatch = 500
+
+// expiryInterval читает AD_EXPIRY_INTERVAL (например, "5m"); по умолчанию раз в минуту.
+func expiryInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AD_EXPIRY_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return time.Minute
+}
+
+// runExpiryWorker архивирует просроченные объявления и снимает закончившееся продвижение
+// раз в interval, пока ctx не отменён. Запускается в каждой реплике; одновременную
+// архивацию исключает advisory lock, снятие продвижения — SKIP LOCKED в репозитории.
+func runExpiryWorker(ctx context.Context, svc *service.AdService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := svc.ArchiveExpired(ctx, expiryBatch)
+		if err != nil {
+			log.Printf("expiry worker: %v", err)
+		} else if n > 0 {
+			log.Printf("expiry worker: archived %d ads", n)
+		}
+		if n, err := svc.ExpirePromotions(ctx, expiryBatch); err != nil {
+			log.Printf("expiry worker: promotions: %v", err)
+		} else if n > 0 {
+			log.Printf("expiry worker: %d promotions ended", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *adServer) RenewAd(ctx context.Context, req *adpb.RenewAdRequest) (*adpb.RenewAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ad, err := s.svc.RenewAd(ctx, req.AdId, req.UserId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.RenewAdResponse{Ad: toPb(ad)}, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *adServer) AddFavorite(ctx context.Context, req *adpb.AddFavoriteRequest) (*adpb.AddFavoriteResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if err := s.svc.AddFavorite(ctx, req.UserId, req.AdId); err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.AddFavoriteResponse{}, nil
+}
+
+func (s *adServer) RemoveFavorite(ctx context.Context, req *adpb.RemoveFavoriteRequest) (*adpb.RemoveFavoriteResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if err := s.svc.RemoveFavorite(ctx, req.UserId, req.AdId); err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.RemoveFavoriteResponse{}, nil
+}
+
+func (s *adServer) ListFavorites(ctx context.Context, req *adpb.ListFavoritesRequest) (*adpb.ListFavoritesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	ads, total, err := s.svc.ListFavorites(ctx, req.UserId, limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	respAds := make([]*adpb.Ad, 0, len(ads))
+	for i := range ads {
+		respAds = append(respAds, toPb(&ads[i]))
+	}
+	return &adpb.ListFavoritesResponse{Ads: respAds, Total: int32(total), Page: int32(page), PageSize: int32(limit)}, nil
+}
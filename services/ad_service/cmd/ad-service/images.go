@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func imagesToPb(images []model.AdImage) []*adpb.AdImage {
+	res := make([]*adpb.AdImage, 0, len(images))
+	for _, img := range images {
+		res = append(res, &adpb.AdImage{Id: img.ID, Url: img.URL, IsPrimary: img.IsPrimary, Position: int32(img.Position)})
+	}
+	return res
+}
+
+func (s *adServer) ReorderImages(ctx context.Context, req *adpb.ReorderImagesRequest) (*adpb.ReorderImagesResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	images, err := s.svc.ReorderImages(ctx, req.AdId, req.UserId, req.ImageIds)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.ReorderImagesResponse{Images: imagesToPb(images)}, nil
+}
+
+func (s *adServer) SetPrimaryImage(ctx context.Context, req *adpb.SetPrimaryImageRequest) (*adpb.SetPrimaryImageResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.ImageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "image_id is required")
+	}
+	images, err := s.svc.SetPrimaryImage(ctx, req.AdId, req.UserId, req.ImageId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.SetPrimaryImageResponse{Images: imagesToPb(images)}, nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+)
+
+// locationFromPb возвращает nil, если местоположение в запросе не передано.
+func locationFromPb(l *adpb.Location) *model.Location {
+	if l == nil {
+		return nil
+	}
+	return &model.Location{City: l.City, Region: l.Region, Lat: l.Lat, Lon: l.Lon}
+}
+
+func locationToPb(l model.Location) *adpb.Location {
+	if l.IsZero() {
+		return nil
+	}
+	return &adpb.Location{City: l.City, Region: l.Region, Lat: l.Lat, Lon: l.Lon}
+}
+
+func geoFilterFromPb(g *adpb.GeoFilter) *model.GeoFilter {
+	if g == nil {
+		return nil
+	}
+	return &model.GeoFilter{Lat: g.Lat, Lon: g.Lon, RadiusKm: g.RadiusKm}
+}
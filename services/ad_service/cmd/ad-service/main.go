@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"78-pflops/services/ad_service/internal/db"
@@ -29,11 +33,44 @@ type adServer struct {
 
 func newServer() *adServer {
 	pool := db.Connect()
+	migrateOnStart(pool)
 	repo := repository.NewAdRepository(pool)
-	svc := service.NewAdService(repo)
+	svc := service.NewAdService(repo, loadConfig())
 	return &adServer{svc: svc}
 }
 
+// loadConfig reads service settings from the environment (.env is loaded by db.Connect).
+func loadConfig() service.Config {
+	var cfg service.Config
+	for _, id := range strings.Split(os.Getenv("AD_ADMIN_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			cfg.AdminIDs = append(cfg.AdminIDs, id)
+		}
+	}
+	if days, err := strconv.Atoi(os.Getenv("AD_DEFAULT_LIFETIME_DAYS")); err == nil && days > 0 {
+		cfg.DefaultAdLifetime = time.Duration(days) * 24 * time.Hour
+	}
+	if days, err := strconv.Atoi(os.Getenv("AD_RESTORE_WINDOW_DAYS")); err == nil && days > 0 {
+		cfg.RestoreWindow = time.Duration(days) * 24 * time.Hour
+	}
+	if n, err := strconv.Atoi(os.Getenv("MAX_IMAGES_PER_AD")); err == nil && n > 0 {
+		cfg.MaxImagesPerAd = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("AD_VIEW_DEDUP_WINDOW")); err == nil && d > 0 {
+		cfg.ViewDedupWindow = d
+	}
+	if n, err := strconv.ParseInt(os.Getenv("AD_PROMOTION_DAY_PRICE"), 10, 64); err == nil && n > 0 {
+		cfg.PromotionDayPrice = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("AD_BUMP_COOLDOWN")); err == nil && d > 0 {
+		cfg.BumpCooldown = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("AD_REPORT_HIDE_THRESHOLD")); err == nil && n > 0 {
+		cfg.ReportHideThreshold = n
+	}
+	return cfg
+}
+
 // helper: convert domain model to protobuf
 func toPb(ad *model.Ad) *adpb.Ad {
 	if ad == nil {
@@ -43,6 +80,10 @@ func toPb(ad *model.Ad) *adpb.Ad {
 	if ad.SellerRatingCached != nil {
 		rating = *ad.SellerRatingCached
 	}
+	var promotedUntil int64
+	if ad.PromotedUntil != nil {
+		promotedUntil = ad.PromotedUntil.Unix()
+	}
 	imageURLs := make([]string, 0, len(ad.Images))
 	for _, img := range ad.Images {
 		if img.URL != "" {
@@ -50,74 +91,93 @@ func toPb(ad *model.Ad) *adpb.Ad {
 		}
 	}
 	return &adpb.Ad{
-		Id:           ad.ID,
-		AuthorId:     ad.AuthorID,
-		Title:        ad.Title,
-		Description:  ad.Description,
-		Price:        ad.Price,
-		CategoryId:   ad.CategoryID,
-		Condition:    ad.Condition,
-		ImageUrls:    imageURLs,
-		SellerRating: rating,
-		CreatedAt:    ad.CreatedAt.Unix(),
-		UpdatedAt:    ad.UpdatedAt.Unix(),
+		Id:                   ad.ID,
+		AuthorId:             ad.AuthorID,
+		Title:                ad.Title,
+		Description:          ad.Description,
+		Price:                ad.Price,
+		CategoryId:           ad.CategoryID,
+		Condition:            ad.Condition,
+		ImageUrls:            imageURLs,
+		SellerRating:         rating,
+		SellerReviewCount:    int32(ad.SellerReviewCount),
+		CreatedAt:            ad.CreatedAt.Unix(),
+		UpdatedAt:            ad.UpdatedAt.Unix(),
+		IsFavorite:           ad.IsFavorite,
+		TitleHighlight:       ad.TitleHighlight,
+		DescriptionHighlight: ad.DescriptionHighlight,
+		Status:               statusToPb(ad.Status),
+		ExpiresAt:            ad.ExpiresAt.Unix(),
+		Images:               imagesToPb(ad.Images),
+		Location:             locationToPb(ad.Location),
+		DistanceKm:           ad.DistanceKm,
+		Attributes:           ad.Attributes,
+		Promoted:             ad.Promoted(time.Now()),
+		PromotedUntil:        promotedUntil,
+		BumpedAt:             ad.BumpedAt.Unix(),
+		Hidden:               ad.HiddenAt != nil,
 	}
 }
 
+// helper: normalize page/page_size from request into page, limit and offset
+func pageParams(reqPage, reqPageSize int32) (page, limit, offset int) {
+	limit = int(reqPageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+	page = int(reqPage)
+	if page <= 0 {
+		page = 1
+	}
+	return page, limit, (page - 1) * limit
+}
+
 // CreateAd implements gRPC CreateAd
 func (s *adServer) CreateAd(ctx context.Context, req *adpb.CreateAdRequest) (*adpb.CreateAdResponse, error) {
-	ad, err := s.svc.CreateAd(ctx, req.UserId, req.Title, req.Description, req.Price)
+	ad, err := s.svc.CreateAd(ctx, req.UserId, req.Title, req.Description, req.Price, req.CategoryId, locationFromPb(req.Location), req.Attributes)
 	if err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.CreateAdResponse{Ad: toPb(ad)}, nil
 }
 
 func (s *adServer) GetAd(ctx context.Context, req *adpb.GetAdRequest) (*adpb.GetAdResponse, error) {
-	ad, err := s.svc.GetAd(ctx, req.Id)
+	ad, err := s.svc.ViewAd(ctx, req.Id, req.ViewerId, req.ClientId)
 	if err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.GetAdResponse{Ad: toPb(ad)}, nil
 }
 
 func (s *adServer) ListAds(ctx context.Context, req *adpb.ListAdsRequest) (*adpb.ListAdsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page, limit, offset := pageParams(req.Page, req.PageSize)
 	var categoryPtr *string
 	if req.CategoryId != "" {
 		categoryPtr = &req.CategoryId
 	}
-	var priceMinPtr *int64
-	if req.PriceMin > 0 {
-		v := req.PriceMin
-		priceMinPtr = &v
-	}
-	var priceMaxPtr *int64
-	if req.PriceMax > 0 {
-		v := req.PriceMax
-		priceMaxPtr = &v
-	}
 	var conditionPtr *string
 	if req.Condition != "" {
 		conditionPtr = &req.Condition
 	}
-	ads, total, err := s.svc.ListAds(ctx, service.Filters{Text: req.Text, CategoryID: categoryPtr, PriceMin: priceMinPtr, PriceMax: priceMaxPtr, Condition: conditionPtr, Limit: limit, Offset: offset})
-	if err != nil {
-		return nil, err
-	}
-	respAds := make([]*adpb.Ad, 0, len(ads))
-	for i := range ads {
-		respAds = append(respAds, toPb(&ads[i]))
+	res, err := s.svc.ListAds(ctx, service.Filters{Text: req.Text, CategoryID: categoryPtr, IncludeSubcategories: req.IncludeSubcategories, PriceMin: req.PriceMin, PriceMax: req.PriceMax, Condition: conditionPtr, MinSellerRating: req.MinSellerRating, City: optionalString(req.City), Region: optionalString(req.Region), Near: geoFilterFromPb(req.Near), Attributes: attributeFiltersFromPb(req.Attributes), Limit: limit, Offset: offset, PageToken: req.PageToken, ViewerID: req.ViewerId, Sort: req.Sort, Status: statusFromPb(req.Status)})
+	if errors.Is(err, repository.ErrInvalidCursor) || errors.Is(err, repository.ErrUnknownSort) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return &adpb.ListAdsResponse{Ads: respAds, Total: int32(total), Page: int32(page), PageSize: int32(limit)}, nil
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	respAds := make([]*adpb.Ad, 0, len(res.Ads))
+	for i := range res.Ads {
+		respAds = append(respAds, toPb(&res.Ads[i]))
+	}
+	return &adpb.ListAdsResponse{
+		Ads:            respAds,
+		Total:          int32(res.Total),
+		Page:           int32(page),
+		PageSize:       int32(limit),
+		NextPageToken:  res.NextPageToken,
+		TotalEstimated: res.TotalEstimated,
+	}, nil
 }
 
 func (s *adServer) UpdateAd(ctx context.Context, req *adpb.UpdateAdRequest) (*adpb.UpdateAdResponse, error) {
@@ -144,19 +204,27 @@ func (s *adServer) UpdateAd(ctx context.Context, req *adpb.UpdateAdRequest) (*ad
 		v := req.Condition.Value
 		conditionPtr = &v
 	}
-	if req.Status != nil {
-		v := req.Status.Value
+	if req.Status != adpb.AdStatus_AD_STATUS_UNSPECIFIED {
+		v := statusFromPb(req.Status)
 		statusPtr = &v
 	}
-	if err := s.svc.UpdateAd(ctx, req.AdId, req.UserId, titlePtr, descPtr, pricePtr, categoryPtr, conditionPtr, statusPtr); err != nil {
-		return nil, err
+	var attrs map[string]string
+	if req.Attributes != nil {
+		// пустой набор тоже заменяет характеристики
+		attrs = req.Attributes.Values
+		if attrs == nil {
+			attrs = map[string]string{}
+		}
+	}
+	if err := s.svc.UpdateAd(ctx, req.AdId, req.UserId, titlePtr, descPtr, pricePtr, categoryPtr, conditionPtr, statusPtr, locationFromPb(req.Location), attrs); err != nil {
+		return nil, statusErr(err)
 	}
 	return &adpb.UpdateAdResponse{}, nil
 }
 
 func (s *adServer) DeleteAd(ctx context.Context, req *adpb.DeleteAdRequest) (*adpb.DeleteAdResponse, error) {
 	if err := s.svc.DeleteAd(ctx, req.AdId, req.UserId); err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.DeleteAdResponse{}, nil
 }
@@ -169,7 +237,7 @@ func (s *adServer) AttachMedia(ctx context.Context, req *adpb.AttachMediaRequest
 		return nil, status.Error(codes.InvalidArgument, "media_id is required")
 	}
 	if err := s.svc.AttachMedia(ctx, req.AdId, req.MediaId); err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.AttachMediaResponse{}, nil
 }
@@ -182,7 +250,7 @@ func (s *adServer) DetachMedia(ctx context.Context, req *adpb.DetachMediaRequest
 		return nil, status.Error(codes.InvalidArgument, "media_id is required")
 	}
 	if err := s.svc.DetachMedia(ctx, req.AdId, req.MediaId); err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.DetachMediaResponse{}, nil
 }
@@ -192,9 +260,9 @@ func (s *adServer) CreateAdWithImages(ctx context.Context, req *adpb.CreateAdWit
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	ad, err := s.svc.CreateAdWithImages(ctx, req.UserId, req.Title, req.Description, req.Price, req.MediaIds)
+	ad, err := s.svc.CreateAdWithImages(ctx, req.UserId, req.Title, req.Description, req.Price, req.CategoryId, locationFromPb(req.Location), req.Attributes, req.MediaIds)
 	if err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 
 	// пока игнорируем фактическую загрузку изображений, mediaIDs = nil
@@ -209,19 +277,33 @@ func (s *adServer) ReplaceImages(ctx context.Context, req *adpb.ReplaceImagesReq
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 	if err := s.svc.ReplaceImages(ctx, req.AdId, req.UserId, req.MediaIds); err != nil {
-		return nil, err
+		return nil, statusErr(err)
 	}
 	return &adpb.ReplaceImagesResponse{}, nil
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	grpcServer := grpc.NewServer()
-	adpb.RegisterAdServiceServer(grpcServer, newServer())
+	srv := newServer()
+	adpb.RegisterAdServiceServer(grpcServer, srv)
+
+	go runExpiryWorker(context.Background(), srv.svc, expiryInterval())
+	go runPurgeWorker(context.Background(), srv.svc, purgeInterval())
+	go runOutboxRelay(context.Background(), srv.svc, newPublisher(), outboxInterval())
+	go runSavedSearchMatcher(context.Background(), srv.svc, matchInterval())
+	go runStatsFlusher(context.Background(), srv.svc, statsFlushInterval())
 
 	reflection.Register(grpcServer)
 
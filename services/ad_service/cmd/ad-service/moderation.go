@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const reportStatusPrefix = "REPORT_STATUS_"
+
+// reportStatusFromPb returns "" for REPORT_STATUS_UNSPECIFIED.
+func reportStatusFromPb(s adpb.ReportStatus) string {
+	if s == adpb.ReportStatus_REPORT_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(s.String(), reportStatusPrefix)
+}
+
+func reportToPb(r *model.Report) *adpb.Report {
+	pb := &adpb.Report{
+		Id:         r.ID,
+		AdId:       r.AdID,
+		ReporterId: r.ReporterID,
+		Reason:     r.Reason,
+		Comment:    r.Comment,
+		Status:     adpb.ReportStatus(adpb.ReportStatus_value[reportStatusPrefix+r.Status]),
+		Resolution: r.Resolution,
+		ReviewerId: r.ReviewerID,
+		CreatedAt:  r.CreatedAt.Unix(),
+	}
+	if r.ResolvedAt != nil {
+		pb.ResolvedAt = r.ResolvedAt.Unix()
+	}
+	return pb
+}
+
+func (s *adServer) ReportAd(ctx context.Context, req *adpb.ReportAdRequest) (*adpb.ReportAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	report, err := s.svc.ReportAd(ctx, req.AdId, req.UserId, req.Reason, req.Comment)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.ReportAdResponse{Report: reportToPb(report)}, nil
+}
+
+func (s *adServer) ListReports(ctx context.Context, req *adpb.ListReportsRequest) (*adpb.ListReportsResponse, error) {
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.ListReports(ctx, req.UserId, reportStatusFromPb(req.Status), req.AdId, limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	resp := &adpb.ListReportsResponse{Total: int32(total), Page: int32(page), PageSize: int32(limit)}
+	for i := range list {
+		resp.Reports = append(resp.Reports, reportToPb(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *adServer) HideAd(ctx context.Context, req *adpb.ModerateAdRequest) (*adpb.ModerateAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if err := s.svc.HideAd(ctx, req.UserId, req.AdId, req.Comment); err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.ModerateAdResponse{}, nil
+}
+
+func (s *adServer) UnhideAd(ctx context.Context, req *adpb.ModerateAdRequest) (*adpb.ModerateAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if err := s.svc.UnhideAd(ctx, req.UserId, req.AdId, req.Comment); err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.ModerateAdResponse{}, nil
+}
+
+func (s *adServer) RejectReport(ctx context.Context, req *adpb.RejectReportRequest) (*adpb.RejectReportResponse, error) {
+	if req.ReportId == "" {
+		return nil, status.Error(codes.InvalidArgument, "report_id is required")
+	}
+	report, err := s.svc.RejectReport(ctx, req.UserId, req.ReportId, req.Comment)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.RejectReportResponse{Report: reportToPb(report)}, nil
+}
+
+func (s *adServer) ListModerationLog(ctx context.Context, req *adpb.ListModerationLogRequest) (*adpb.ListModerationLogResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.ListModerationLog(ctx, req.UserId, req.AdId, limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	resp := &adpb.ListModerationLogResponse{Total: int32(total), Page: int32(page), PageSize: int32(limit)}
+	for _, a := range list {
+		pb := &adpb.ModerationAction{
+			Id:        a.ID,
+			AdId:      a.AdID,
+			ActorId:   a.ActorID,
+			Action:    a.Action,
+			Comment:   a.Comment,
+			CreatedAt: a.CreatedAt.Unix(),
+		}
+		if a.ReportID != nil {
+			pb.ReportId = *a.ReportID
+		}
+		resp.Actions = append(resp.Actions, pb)
+	}
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"78-pflops/services/ad_service/internal/events"
+	"78-pflops/services/ad_service/internal/service"
+)
+
+// outboxBatch — сколько событий публикуется за одну транзакцию релея.
+const outboxBatch = 100
+
+// outboxInterval читает AD_OUTBOX_INTERVAL (например, "500ms"); по умолчанию раз в секунду.
+func outboxInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AD_OUTBOX_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return time.Second
+}
+
+// newPublisher выбирает приёмник событий: AD_EVENTS_FILE — JSON Lines файл для локальной
+// проверки, иначе события пишутся в лог.
+func newPublisher() service.Publisher {
+	path := os.Getenv("AD_EVENTS_FILE")
+	if path == "" {
+		return events.LogPublisher{}
+	}
+	pub, err := events.NewFilePublisher(path)
+	if err != nil {
+		log.Fatalf("events file: %v", err)
+	}
+	return pub
+}
+
+// runOutboxRelay публикует события из ad_events раз в interval, пока ctx не отменён.
+// Полные пачки отправляются подряд без ожидания. Реплики не мешают друг другу:
+// пока релей одной из них держит блокировку, остальные пропускают проход.
+func runOutboxRelay(ctx context.Context, svc *service.AdService, pub service.Publisher, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := svc.RelayEvents(ctx, pub, outboxBatch)
+		if err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+		if err == nil && n == outboxBatch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *adServer) GetPriceHistory(ctx context.Context, req *adpb.GetPriceHistoryRequest) (*adpb.GetPriceHistoryResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	history, err := s.svc.GetPriceHistory(ctx, req.AdId, req.ViewerId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	points := make([]*adpb.PricePoint, 0, len(history))
+	for _, p := range history {
+		points = append(points, &adpb.PricePoint{Price: p.Price, ChangedAt: p.ChangedAt.Unix()})
+	}
+	return &adpb.GetPriceHistoryResponse{Points: points}, nil
+}
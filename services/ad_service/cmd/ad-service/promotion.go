@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ledgerEntryToPb(e *model.LedgerEntry) *adpb.LedgerEntry {
+	pb := &adpb.LedgerEntry{
+		Id:           e.ID,
+		Amount:       e.Amount,
+		Reason:       e.Reason,
+		Comment:      e.Comment,
+		BalanceAfter: e.BalanceAfter,
+		CreatedAt:    e.CreatedAt.Unix(),
+	}
+	if e.AdID != nil {
+		pb.AdId = *e.AdID
+	}
+	return pb
+}
+
+func (s *adServer) PromoteAd(ctx context.Context, req *adpb.PromoteAdRequest) (*adpb.PromoteAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ad, balance, err := s.svc.PromoteAd(ctx, req.AdId, req.UserId, int(req.Days))
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.PromoteAdResponse{Ad: toPb(ad), Balance: balance}, nil
+}
+
+func (s *adServer) BumpAd(ctx context.Context, req *adpb.BumpAdRequest) (*adpb.BumpAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ad, err := s.svc.BumpAd(ctx, req.AdId, req.UserId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.BumpAdResponse{Ad: toPb(ad)}, nil
+}
+
+func (s *adServer) GetBalance(ctx context.Context, req *adpb.GetBalanceRequest) (*adpb.GetBalanceResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	balance, entries, total, err := s.svc.GetBalance(ctx, req.UserId, limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	resp := &adpb.GetBalanceResponse{Balance: balance, Total: int32(total), Page: int32(page), PageSize: int32(limit)}
+	for i := range entries {
+		resp.Entries = append(resp.Entries, ledgerEntryToPb(&entries[i]))
+	}
+	return resp, nil
+}
+
+func (s *adServer) CreditBalance(ctx context.Context, req *adpb.CreditBalanceRequest) (*adpb.CreditBalanceResponse, error) {
+	if req.UserId == "" || req.TargetUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and target_user_id are required")
+	}
+	entry, err := s.svc.CreditBalance(ctx, req.UserId, req.TargetUserId, req.Amount, req.Comment)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.CreditBalanceResponse{Entry: ledgerEntryToPb(entry)}, nil
+}
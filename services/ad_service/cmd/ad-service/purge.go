@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purgeBatch — сколько удалённых объявлений стирается одним запросом.
+const purgeBatch = 200
+
+// purgeInterval читает AD_PURGE_INTERVAL (например, "30m"); по умолчанию раз в час.
+func purgeInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AD_PURGE_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return time.Hour
+}
+
+// runPurgeWorker окончательно удаляет объявления с истёкшим окном восстановления раз в interval.
+func runPurgeWorker(ctx context.Context, svc *service.AdService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := svc.PurgeDeleted(ctx, purgeBatch)
+		if err != nil {
+			log.Printf("purge worker: %v", err)
+		} else if n > 0 {
+			log.Printf("purge worker: purged %d deleted ads", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *adServer) RestoreAd(ctx context.Context, req *adpb.RestoreAdRequest) (*adpb.RestoreAdResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ad, err := s.svc.RestoreAd(ctx, req.AdId, req.UserId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.RestoreAdResponse{Ad: toPb(ad)}, nil
+}
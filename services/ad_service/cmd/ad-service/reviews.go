@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// helper: convert review domain model to protobuf
+func reviewToPb(rv *model.Review) *adpb.Review {
+	var comment string
+	if rv.Comment != nil {
+		comment = *rv.Comment
+	}
+	return &adpb.Review{
+		Id:         rv.ID,
+		AdId:       rv.AdID,
+		ReviewerId: rv.ReviewerID,
+		Rating:     int32(rv.Rating),
+		Comment:    comment,
+		CreatedAt:  rv.CreatedAt.Unix(),
+	}
+}
+
+func reviewsToPb(list []model.Review, total, page, limit int) *adpb.ListReviewsResponse {
+	reviews := make([]*adpb.Review, 0, len(list))
+	for i := range list {
+		reviews = append(reviews, reviewToPb(&list[i]))
+	}
+	return &adpb.ListReviewsResponse{Reviews: reviews, Total: int32(total), Page: int32(page), PageSize: int32(limit)}
+}
+
+func (s *adServer) CreateReview(ctx context.Context, req *adpb.CreateReviewRequest) (*adpb.CreateReviewResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	rv, err := s.svc.CreateReview(ctx, req.UserId, req.AdId, int(req.Rating), req.Comment)
+	switch {
+	case errors.Is(err, service.ErrInvalidRating):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrOwnAdReview):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, repository.ErrReviewExists):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		return nil, err
+	}
+	return &adpb.CreateReviewResponse{Review: reviewToPb(rv)}, nil
+}
+
+func (s *adServer) DeleteReview(ctx context.Context, req *adpb.DeleteReviewRequest) (*adpb.DeleteReviewResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.ReviewId == "" {
+		return nil, status.Error(codes.InvalidArgument, "review_id is required")
+	}
+	if err := s.svc.DeleteReview(ctx, req.UserId, req.ReviewId); err != nil {
+		return nil, err
+	}
+	return &adpb.DeleteReviewResponse{}, nil
+}
+
+func (s *adServer) ListAdReviews(ctx context.Context, req *adpb.ListAdReviewsRequest) (*adpb.ListReviewsResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.ListAdReviews(ctx, req.AdId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return reviewsToPb(list, total, page, limit), nil
+}
+
+func (s *adServer) ListSellerReviews(ctx context.Context, req *adpb.ListSellerReviewsRequest) (*adpb.ListReviewsResponse, error) {
+	if req.SellerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "seller_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.ListSellerReviews(ctx, req.SellerId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return reviewsToPb(list, total, page, limit), nil
+}
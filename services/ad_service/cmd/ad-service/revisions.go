@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func revisionToPb(rev *model.AdRevision) *adpb.AdRevision {
+	changes := make([]*adpb.FieldChange, 0, len(rev.Changes))
+	for _, c := range rev.Changes {
+		changes = append(changes, &adpb.FieldChange{Field: c.Field, OldValue: c.Old, NewValue: c.New})
+	}
+	return &adpb.AdRevision{
+		Id:        rev.ID,
+		AdId:      rev.AdID,
+		Revision:  int32(rev.Revision),
+		ActorId:   rev.ActorID,
+		Changes:   changes,
+		CreatedAt: rev.CreatedAt.Unix(),
+	}
+}
+
+func (s *adServer) ListAdRevisions(ctx context.Context, req *adpb.ListAdRevisionsRequest) (*adpb.ListAdRevisionsResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.ListAdRevisions(ctx, req.AdId, req.UserId, limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	revisions := make([]*adpb.AdRevision, 0, len(list))
+	for i := range list {
+		revisions = append(revisions, revisionToPb(&list[i]))
+	}
+	return &adpb.ListAdRevisionsResponse{Revisions: revisions, Total: int32(total), Page: int32(page), PageSize: int32(limit)}, nil
+}
+
+func (s *adServer) GetAdRevision(ctx context.Context, req *adpb.GetAdRevisionRequest) (*adpb.GetAdRevisionResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision must be positive")
+	}
+	rev, err := s.svc.GetAdRevision(ctx, req.AdId, req.UserId, int(req.Revision))
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.GetAdRevisionResponse{Revision: revisionToPb(rev)}, nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// matchBatch — сколько новых объявлений проверяется за один запрос матчера.
+const matchBatch = 200
+
+// matchInterval читает AD_SAVED_SEARCH_INTERVAL (например, "1m"); по умолчанию раз в 30 секунд.
+func matchInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AD_SAVED_SEARCH_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return 30 * time.Second
+}
+
+// runSavedSearchMatcher сопоставляет новые объявления с сохранёнными поисками раз в interval.
+// Реплики разбирают очередь параллельно: занятые строки пропускаются (SKIP LOCKED).
+func runSavedSearchMatcher(ctx context.Context, svc *service.AdService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := svc.MatchSavedSearches(ctx, matchBatch)
+		if err != nil {
+			log.Printf("saved search matcher: %v", err)
+		} else if n > 0 {
+			log.Printf("saved search matcher: %d new matches", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// savedSearchErr дополняет statusErr ошибками сохранённых поисков.
+func savedSearchErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidSavedSearch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrSavedSearchNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrTooManySavedSearches):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return statusErr(err)
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func savedSearchToPb(ss *model.SavedSearch) *adpb.SavedSearch {
+	res := &adpb.SavedSearch{
+		Id:                   ss.ID,
+		Name:                 ss.Name,
+		Text:                 ss.Text,
+		IncludeSubcategories: ss.IncludeSubcategories,
+		PriceMin:             ss.PriceMin,
+		PriceMax:             ss.PriceMax,
+		NewMatches:           int32(ss.NewMatches),
+		LastSeenAt:           ss.LastSeenAt.Unix(),
+		CreatedAt:            ss.CreatedAt.Unix(),
+		UpdatedAt:            ss.UpdatedAt.Unix(),
+	}
+	if ss.CategoryID != nil {
+		res.CategoryId = *ss.CategoryID
+	}
+	if ss.Condition != nil {
+		res.Condition = *ss.Condition
+	}
+	return res
+}
+
+func (s *adServer) CreateSavedSearch(ctx context.Context, req *adpb.CreateSavedSearchRequest) (*adpb.CreateSavedSearchResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ss := &model.SavedSearch{
+		UserID:               req.UserId,
+		Name:                 req.Name,
+		Text:                 req.Text,
+		CategoryID:           optionalString(req.CategoryId),
+		IncludeSubcategories: req.IncludeSubcategories,
+		PriceMin:             req.PriceMin,
+		PriceMax:             req.PriceMax,
+		Condition:            optionalString(req.Condition),
+	}
+	if err := s.svc.CreateSavedSearch(ctx, ss); err != nil {
+		return nil, savedSearchErr(err)
+	}
+	return &adpb.CreateSavedSearchResponse{SavedSearch: savedSearchToPb(ss)}, nil
+}
+
+func (s *adServer) UpdateSavedSearch(ctx context.Context, req *adpb.UpdateSavedSearchRequest) (*adpb.UpdateSavedSearchResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ss := &model.SavedSearch{
+		ID:                   req.Id,
+		UserID:               req.UserId,
+		Name:                 req.Name,
+		Text:                 req.Text,
+		CategoryID:           optionalString(req.CategoryId),
+		IncludeSubcategories: req.IncludeSubcategories,
+		PriceMin:             req.PriceMin,
+		PriceMax:             req.PriceMax,
+		Condition:            optionalString(req.Condition),
+	}
+	if err := s.svc.UpdateSavedSearch(ctx, ss); err != nil {
+		return nil, savedSearchErr(err)
+	}
+	return &adpb.UpdateSavedSearchResponse{SavedSearch: savedSearchToPb(ss)}, nil
+}
+
+func (s *adServer) DeleteSavedSearch(ctx context.Context, req *adpb.DeleteSavedSearchRequest) (*adpb.DeleteSavedSearchResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := s.svc.DeleteSavedSearch(ctx, req.Id, req.UserId); err != nil {
+		return nil, savedSearchErr(err)
+	}
+	return &adpb.DeleteSavedSearchResponse{}, nil
+}
+
+func (s *adServer) ListSavedSearches(ctx context.Context, req *adpb.ListSavedSearchesRequest) (*adpb.ListSavedSearchesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	list, totalNew, err := s.svc.ListSavedSearches(ctx, req.UserId)
+	if err != nil {
+		return nil, savedSearchErr(err)
+	}
+	searches := make([]*adpb.SavedSearch, 0, len(list))
+	for i := range list {
+		searches = append(searches, savedSearchToPb(&list[i]))
+	}
+	return &adpb.ListSavedSearchesResponse{SavedSearches: searches, TotalNewMatches: int32(totalNew)}, nil
+}
+
+func (s *adServer) ListSavedSearchMatches(ctx context.Context, req *adpb.ListSavedSearchMatchesRequest) (*adpb.ListSavedSearchMatchesResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	ads, total, err := s.svc.ListSavedSearchMatches(ctx, req.Id, req.UserId, limit, offset, req.MarkSeen)
+	if err != nil {
+		return nil, savedSearchErr(err)
+	}
+	respAds := make([]*adpb.Ad, 0, len(ads))
+	for i := range ads {
+		respAds = append(respAds, toPb(&ads[i]))
+	}
+	return &adpb.ListSavedSearchMatchesResponse{Ads: respAds, Total: int32(total), Page: int32(page), PageSize: int32(limit)}, nil
+}
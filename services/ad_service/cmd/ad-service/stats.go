@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statsFlushInterval читает AD_STATS_FLUSH_INTERVAL (например, "30s"); по умолчанию раз в 10 секунд.
+func statsFlushInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AD_STATS_FLUSH_INTERVAL")); err == nil && d > 0 {
+		return d
+	}
+	return 10 * time.Second
+}
+
+// runStatsFlusher переносит накопленные в памяти просмотры и счётчики в базу раз в interval.
+// При остановке реплики несброшенная часть буфера теряется.
+func runStatsFlusher(ctx context.Context, svc *service.AdService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := svc.FlushStats(ctx); err != nil {
+			log.Printf("stats flusher: %v", err)
+		}
+	}
+}
+
+func (s *adServer) GetAdStats(ctx context.Context, req *adpb.GetAdStatsRequest) (*adpb.GetAdStatsResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	days, err := s.svc.GetAdStats(ctx, req.AdId, req.UserId, int(req.Days))
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	resp := &adpb.GetAdStatsResponse{Days: make([]*adpb.AdDailyStats, 0, len(days))}
+	for _, d := range days {
+		resp.Days = append(resp.Days, &adpb.AdDailyStats{
+			Day:            d.Day.Format("2006-01-02"),
+			Views:          int32(d.Views),
+			Favorites:      int32(d.Favorites),
+			ContactReveals: int32(d.ContactReveals),
+		})
+		resp.TotalViews += int32(d.Views)
+		resp.TotalFavorites += int32(d.Favorites)
+		resp.TotalContactReveals += int32(d.ContactReveals)
+	}
+	return resp, nil
+}
+
+func (s *adServer) GetSellerStats(ctx context.Context, req *adpb.GetSellerStatsRequest) (*adpb.GetSellerStatsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	page, limit, offset := pageParams(req.Page, req.PageSize)
+	list, total, err := s.svc.GetSellerStats(ctx, req.UserId, int(req.Days), limit, offset)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	ads := make([]*adpb.AdStatsSummary, 0, len(list))
+	for _, a := range list {
+		ads = append(ads, &adpb.AdStatsSummary{
+			AdId:           a.AdID,
+			Title:          a.Title,
+			Status:         statusToPb(a.Status),
+			Views:          int32(a.Views),
+			Favorites:      int32(a.Favorites),
+			ContactReveals: int32(a.ContactReveals),
+		})
+	}
+	return &adpb.GetSellerStatsResponse{Ads: ads, Total: int32(total), Page: int32(page), PageSize: int32(limit)}, nil
+}
+
+func (s *adServer) RecordContactReveal(ctx context.Context, req *adpb.RecordContactRevealRequest) (*adpb.RecordContactRevealResponse, error) {
+	if req.AdId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ad_id is required")
+	}
+	sellerID, err := s.svc.RecordContactReveal(ctx, req.AdId, req.UserId)
+	if err != nil {
+		return nil, statusErr(err)
+	}
+	return &adpb.RecordContactRevealResponse{SellerId: sellerID}, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"78-pflops/services/ad_service/internal/repository"
+	"78-pflops/services/ad_service/internal/service"
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const statusPrefix = "AD_STATUS_"
+
+// statusToPb converts model.Status* (ACTIVE, SOLD, ...) to the proto enum.
+func statusToPb(s string) adpb.AdStatus {
+	return adpb.AdStatus(adpb.AdStatus_value[statusPrefix+s])
+}
+
+// statusFromPb returns "" for AD_STATUS_UNSPECIFIED.
+func statusFromPb(s adpb.AdStatus) string {
+	if s == adpb.AdStatus_AD_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(s.String(), statusPrefix)
+}
+
+// statusErr maps ad validation and status lifecycle errors to gRPC codes and falls back to categoryErr.
+func statusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidStatus),
+		errors.Is(err, service.ErrInvalidCondition),
+		errors.Is(err, service.ErrInvalidFilter),
+		errors.Is(err, service.ErrTooManyImages),
+		errors.Is(err, service.ErrInvalidImageOrder),
+		errors.Is(err, service.ErrInvalidLocation),
+		errors.Is(err, service.ErrInvalidAttributes),
+		errors.Is(err, service.ErrInvalidAttributeSchema),
+		errors.Is(err, service.ErrInvalidPromotion),
+		errors.Is(err, service.ErrInvalidAmount),
+		errors.Is(err, service.ErrInvalidReport):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrStatusTransition),
+		errors.Is(err, service.ErrRestoreWindowExpired),
+		errors.Is(err, service.ErrContactsUnavailable),
+		errors.Is(err, service.ErrAdNotActive),
+		errors.Is(err, repository.ErrInsufficientFunds),
+		errors.Is(err, service.ErrReportResolved),
+		errors.Is(err, service.ErrAdNotHidden):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrBumpCooldown):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, repository.ErrStatusConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, repository.ErrDuplicateReport):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrAdNotFound),
+		errors.Is(err, repository.ErrFavoriteNotFound),
+		errors.Is(err, service.ErrRevisionNotFound),
+		errors.Is(err, service.ErrImageNotFound),
+		errors.Is(err, service.ErrReportNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return categoryErr(err)
+}
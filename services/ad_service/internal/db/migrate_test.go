@@ -0,0 +1,87 @@
+package db
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	sub, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrations, err := LoadMigrations(sub)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i, m := range migrations {
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			t.Errorf("%s is out of order", m)
+		}
+		if m.Down == "" {
+			t.Errorf("%s has no down script", m)
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"2_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+		"1_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+		"1_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"README.md":    {Data: []byte("ignored")},
+	}
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(migrations) != 2 || migrations[0].String() != "1_a" || migrations[1].String() != "2_b" {
+		t.Fatalf("unexpected migrations %v", migrations)
+	}
+	if migrations[0].Down != "DROP TABLE a;" || migrations[1].Down != "" {
+		t.Errorf("down scripts not paired")
+	}
+	if migrations[0].Checksum == "" || migrations[0].Checksum == migrations[1].Checksum {
+		t.Errorf("expected distinct checksums")
+	}
+
+	bad := []fstest.MapFS{
+		{"1_a.sql": {Data: []byte("x")}},
+		{"a_b.up.sql": {Data: []byte("x")}},
+		{"1.up.sql": {Data: []byte("x")}},
+		{"1_a.down.sql": {Data: []byte("x")}},
+		{"1_a.up.sql": {Data: []byte("x")}, "1_b.up.sql": {Data: []byte("y")}},
+	}
+	for _, fsys := range bad {
+		if _, err := LoadMigrations(fsys); err == nil {
+			t.Errorf("expected error for %v", fsys)
+		}
+	}
+}
+
+func TestVerifyApplied(t *testing.T) {
+	migrations, err := LoadMigrations(fstest.MapFS{
+		"1_a.up.sql": {Data: []byte("CREATE TABLE a ();")},
+		"2_b.up.sql": {Data: []byte("CREATE TABLE b ();")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok := map[int64]appliedMigration{1: {checksum: migrations[0].Checksum}}
+	if err := verifyApplied(migrations, ok); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	modified := map[int64]appliedMigration{1: {checksum: "deadbeef"}}
+	if err := verifyApplied(migrations, modified); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("expected ErrChecksumMismatch, got %v", err)
+	}
+	unknown := map[int64]appliedMigration{3: {checksum: "x"}}
+	if err := verifyApplied(migrations, unknown); !errors.Is(err, ErrUnknownMigration) {
+		t.Errorf("expected ErrUnknownMigration, got %v", err)
+	}
+}
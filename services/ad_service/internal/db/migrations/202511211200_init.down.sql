@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ad_reviews;
+DROP TABLE IF EXISTS favorites;
+DROP TABLE IF EXISTS ad_images;
+DROP TABLE IF EXISTS ads;
+DROP TABLE IF EXISTS categories;
@@ -48,6 +48,4 @@ CREATE INDEX IF NOT EXISTS idx_ads_category_price ON ads(category_id, price);
 CREATE INDEX IF NOT EXISTS idx_ads_author ON ads(author_id);
 CREATE INDEX IF NOT EXISTS idx_ad_images_ad_id ON ad_images(ad_id);
 CREATE INDEX IF NOT EXISTS idx_ad_reviews_ad_id ON ad_reviews(ad_id);
--- Fulltext (will require extension)
--- CREATE EXTENSION IF NOT EXISTS pg_trgm;
--- CREATE INDEX IF NOT EXISTS idx_ads_fulltext ON ads USING GIN (to_tsvector('russian', title || ' ' || description));
+-- Fulltext: see 202610171200_fulltext.up.sql
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ad_reviews_reviewer;
+DROP INDEX IF EXISTS uq_ad_reviews_ad_reviewer;
+ALTER TABLE ads DROP COLUMN IF EXISTS seller_review_count;
@@ -0,0 +1,5 @@
+-- Reviews: one review per reviewer per ad, cached review count per seller
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS seller_review_count INT NOT NULL DEFAULT 0;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_ad_reviews_ad_reviewer ON ad_reviews(ad_id, reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_ad_reviews_reviewer ON ad_reviews(reviewer_id);
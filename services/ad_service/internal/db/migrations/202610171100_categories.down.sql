@@ -0,0 +1,6 @@
+-- Seeded categories are kept: ads may still reference them
+ALTER TABLE ads DROP CONSTRAINT IF EXISTS fk_ads_category;
+DROP INDEX IF EXISTS idx_categories_parent;
+ALTER TABLE categories DROP COLUMN IF EXISTS created_at;
+ALTER TABLE categories DROP COLUMN IF EXISTS archived;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,33 @@
+-- Category catalogue: hierarchy, archiving and a foreign key from ads
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES categories(id);
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories(parent_id);
+
+-- Default category: ads created before the catalogue existed used the zero UUID
+INSERT INTO categories (id, slug, name) VALUES ('00000000-0000-0000-0000-000000000000', 'general', 'Разное')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO categories (id, slug, name) VALUES
+    (gen_random_uuid(), 'electronics', 'Электроника'),
+    (gen_random_uuid(), 'clothes', 'Одежда и обувь'),
+    (gen_random_uuid(), 'home', 'Дом и сад'),
+    (gen_random_uuid(), 'auto', 'Авто'),
+    (gen_random_uuid(), 'hobby', 'Хобби и отдых')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO categories (id, slug, name, parent_id)
+SELECT gen_random_uuid(), c.slug, c.name, p.id
+FROM (VALUES
+    ('phones', 'Телефоны', 'electronics'),
+    ('laptops', 'Ноутбуки', 'electronics'),
+    ('auto-parts', 'Запчасти', 'auto')
+) AS c(slug, name, parent_slug)
+JOIN categories p ON p.slug = c.parent_slug
+ON CONFLICT (slug) DO NOTHING;
+
+UPDATE ads SET category_id = '00000000-0000-0000-0000-000000000000'
+WHERE category_id NOT IN (SELECT id FROM categories);
+
+ALTER TABLE ads ADD CONSTRAINT fk_ads_category FOREIGN KEY (category_id) REFERENCES categories(id);
@@ -0,0 +1,10 @@
+-- Full-text search: stored tsvector kept up to date by PostgreSQL on every write.
+-- The 'russian' configuration stems Cyrillic words with the Russian snowball stemmer
+-- and Latin (ASCII) words with the English one, so both languages get morphology.
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_ads_search_vector ON ads USING GIN (search_vector);
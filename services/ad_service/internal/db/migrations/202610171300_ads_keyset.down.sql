@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_ads_created_id;
@@ -0,0 +1,2 @@
+-- Keyset pagination for ListAds: (created_at, id) is the default sort key
+CREATE INDEX IF NOT EXISTS idx_ads_created_id ON ads(created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_ads_rating_sort;
+DROP INDEX IF EXISTS idx_ads_price_id;
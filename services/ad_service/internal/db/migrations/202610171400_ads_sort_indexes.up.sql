@@ -0,0 +1,3 @@
+-- Indexes backing the ListAds sort orders (price and seller rating) with their id tie-breakers
+CREATE INDEX IF NOT EXISTS idx_ads_price_id ON ads(price, id);
+CREATE INDEX IF NOT EXISTS idx_ads_rating_sort ON ads((COALESCE(seller_rating_cached, 0)) DESC, seller_review_count DESC, created_at DESC, id DESC);
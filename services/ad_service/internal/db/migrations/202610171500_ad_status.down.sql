@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_ads_author_status;
+DROP INDEX IF EXISTS idx_ads_status_created_id;
+DROP TABLE IF EXISTS ad_status_history;
+ALTER TABLE ads DROP CONSTRAINT IF EXISTS chk_ads_status;
@@ -0,0 +1,19 @@
+-- Ad status lifecycle: allowed values and transition history
+UPDATE ads SET status = 'INACTIVE' WHERE status NOT IN ('ACTIVE', 'INACTIVE', 'SOLD', 'ARCHIVED');
+
+ALTER TABLE ads ADD CONSTRAINT chk_ads_status CHECK (status IN ('ACTIVE', 'INACTIVE', 'SOLD', 'ARCHIVED'));
+
+CREATE TABLE IF NOT EXISTS ad_status_history (
+    id UUID PRIMARY KEY,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    changed_by UUID, -- NULL for system changes
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ad_status_history_ad ON ad_status_history(ad_id, changed_at);
+
+-- Public listing filters by status; owners list their own ads by status
+CREATE INDEX IF NOT EXISTS idx_ads_status_created_id ON ads(status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_ads_author_status ON ads(author_id, status);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ads_active_expires;
+ALTER TABLE ads DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE categories DROP COLUMN IF EXISTS ad_lifetime_days;
@@ -0,0 +1,13 @@
+-- Ad expiry: per-category lifetime and expires_at on ads
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS ad_lifetime_days INT CHECK (ad_lifetime_days > 0); -- NULL: inherit from parent / service default
+
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+-- Existing ads get the default 30 days, but at least a week from now so nothing is archived right after the deploy
+UPDATE ads SET expires_at = GREATEST(created_at + INTERVAL '30 days', NOW() + INTERVAL '7 days')
+WHERE expires_at IS NULL;
+
+ALTER TABLE ads ALTER COLUMN expires_at SET NOT NULL;
+
+-- The archiving worker scans active ads by expiry
+CREATE INDEX IF NOT EXISTS idx_ads_active_expires ON ads(expires_at) WHERE status = 'ACTIVE';
@@ -0,0 +1 @@
+ALTER TABLE ads DROP CONSTRAINT IF EXISTS chk_ads_condition;
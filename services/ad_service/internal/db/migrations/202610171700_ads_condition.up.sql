@@ -0,0 +1,2 @@
+-- Allowed item conditions. NOT VALID: existing rows are not re-checked, new and updated rows are.
+ALTER TABLE ads ADD CONSTRAINT chk_ads_condition CHECK (condition IN ('NEW', 'USED', 'REFURBISHED')) NOT VALID;
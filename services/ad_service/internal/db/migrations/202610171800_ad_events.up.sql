@@ -0,0 +1,14 @@
+-- Transactional outbox: ad lifecycle events written together with the change itself
+-- and delivered by the relay (at-least-once; consumers deduplicate by id).
+CREATE TABLE IF NOT EXISTS ad_events (
+    seq BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY, -- delivery order
+    id UUID NOT NULL UNIQUE,
+    ad_id UUID NOT NULL, -- no FK: events outlive deleted ads
+    type TEXT NOT NULL,
+    actor_id UUID, -- NULL for system changes
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_ad_events_pending ON ad_events(seq) WHERE published_at IS NULL;
@@ -0,0 +1,4 @@
+-- Soft-deleted ads would become visible again, so they are removed for good
+DELETE FROM ads WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_ads_deleted_at;
+ALTER TABLE ads DROP COLUMN IF EXISTS deleted_at;
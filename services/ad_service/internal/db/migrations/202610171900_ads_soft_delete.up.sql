@@ -0,0 +1,5 @@
+-- Soft delete: DeleteAd only marks the ad; images, favorites and reviews survive until the purge job
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- The purge job scans deleted ads by deletion time
+CREATE INDEX IF NOT EXISTS idx_ads_deleted_at ON ads(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,10 @@
+-- Revision history: every UpdateAd / ReplaceImages change with a field-level diff and its author
+CREATE TABLE IF NOT EXISTS ad_revisions (
+    id UUID PRIMARY KEY,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    revision INT NOT NULL, -- 1, 2, ... per ad
+    actor_id UUID,
+    changes JSONB NOT NULL, -- [{"field": ..., "old": ..., "new": ...}]
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (ad_id, revision)
+);
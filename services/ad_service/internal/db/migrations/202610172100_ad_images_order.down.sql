@@ -0,0 +1 @@
+DROP INDEX IF EXISTS uq_ad_images_primary;
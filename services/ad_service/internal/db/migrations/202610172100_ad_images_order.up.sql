@@ -0,0 +1,17 @@
+-- Image ordering: positions 1..n per ad and exactly one primary image.
+-- Images attached before this migration all have position 0; keep their insertion order by id.
+UPDATE ad_images i SET position = n.rn
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY ad_id ORDER BY position, id) AS rn FROM ad_images) n
+WHERE i.id = n.id;
+
+-- At most one primary: keep the first one
+UPDATE ad_images i SET is_primary = FALSE
+WHERE is_primary AND EXISTS (
+    SELECT 1 FROM ad_images j WHERE j.ad_id = i.ad_id AND j.is_primary AND j.position < i.position
+);
+
+-- Ads with images but no primary get the first one
+UPDATE ad_images i SET is_primary = TRUE
+WHERE position = 1 AND NOT EXISTS (SELECT 1 FROM ad_images j WHERE j.ad_id = i.ad_id AND j.is_primary);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_ad_images_primary ON ad_images(ad_id) WHERE is_primary;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS price_drop_notifications;
+DROP TABLE IF EXISTS ad_price_history;
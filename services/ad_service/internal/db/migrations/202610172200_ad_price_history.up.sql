@@ -0,0 +1,25 @@
+-- Price history: the initial price and every change made through UpdateAd
+CREATE TABLE IF NOT EXISTS ad_price_history (
+    id UUID PRIMARY KEY,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    price BIGINT NOT NULL,
+    changed_by UUID,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_ad_price_history_ad ON ad_price_history(ad_id, changed_at);
+
+-- Existing ads start their history with the current price
+INSERT INTO ad_price_history (id, ad_id, price, changed_by, changed_at)
+SELECT gen_random_uuid(), id, price, author_id, created_at FROM ads;
+
+-- One record per user who had the ad in favorites when its price went down
+CREATE TABLE IF NOT EXISTS price_drop_notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    old_price BIGINT NOT NULL,
+    new_price BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    read_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_price_drop_notifications_user ON price_drop_notifications(user_id, created_at DESC);
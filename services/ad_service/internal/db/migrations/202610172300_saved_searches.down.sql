@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS saved_search_queue;
+DROP TABLE IF EXISTS saved_search_matches;
+DROP TABLE IF EXISTS saved_searches;
@@ -0,0 +1,31 @@
+-- Saved ListAds filters; new_matches counts matches found since the owner last looked at them
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    query TEXT NOT NULL DEFAULT '',
+    category_id UUID REFERENCES categories(id),
+    include_subcategories BOOLEAN NOT NULL DEFAULT FALSE,
+    price_min BIGINT,
+    price_max BIGINT,
+    condition TEXT,
+    new_matches INT NOT NULL DEFAULT 0,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user ON saved_searches(user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+    saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    matched_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (saved_search_id, ad_id)
+);
+CREATE INDEX IF NOT EXISTS idx_saved_search_matches_recent ON saved_search_matches(saved_search_id, matched_at DESC);
+
+-- Ads waiting for the matcher; written in the same transaction as the ad itself
+CREATE TABLE IF NOT EXISTS saved_search_queue (
+    ad_id UUID PRIMARY KEY REFERENCES ads(id) ON DELETE CASCADE,
+    enqueued_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
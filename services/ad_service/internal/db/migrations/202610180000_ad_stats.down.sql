@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ad_view_dedup;
+DROP TABLE IF EXISTS ad_stats_daily;
@@ -0,0 +1,18 @@
+-- Daily per-ad counters, filled by the buffered stats flush in ad_service
+CREATE TABLE IF NOT EXISTS ad_stats_daily (
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    day DATE NOT NULL, -- UTC
+    views INT NOT NULL DEFAULT 0,
+    favorites INT NOT NULL DEFAULT 0,
+    contact_reveals INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (ad_id, day)
+);
+
+-- Last counted view per ad and viewer: a repeated view inside the dedup window is not counted
+CREATE TABLE IF NOT EXISTS ad_view_dedup (
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    viewer_key TEXT NOT NULL, -- user id or "anon:<client id>"
+    seen_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (ad_id, viewer_key)
+);
+CREATE INDEX IF NOT EXISTS idx_ad_view_dedup_seen ON ad_view_dedup(seen_at);
@@ -0,0 +1,10 @@
+DROP FUNCTION IF EXISTS ad_distance_km(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+DROP INDEX IF EXISTS idx_ads_coordinates;
+DROP INDEX IF EXISTS idx_ads_region;
+DROP INDEX IF EXISTS idx_ads_city;
+ALTER TABLE ads DROP CONSTRAINT IF EXISTS chk_ads_coordinates;
+ALTER TABLE ads
+    DROP COLUMN IF EXISTS lon,
+    DROP COLUMN IF EXISTS lat,
+    DROP COLUMN IF EXISTS region,
+    DROP COLUMN IF EXISTS city;
@@ -0,0 +1,25 @@
+-- Ad location: city and/or region from the bundled list (internal/geo) plus optional coordinates
+ALTER TABLE ads
+    ADD COLUMN IF NOT EXISTS city TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS region TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS lat DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS lon DOUBLE PRECISION;
+ALTER TABLE ads ADD CONSTRAINT chk_ads_coordinates CHECK (
+    (lat IS NULL) = (lon IS NULL) AND lat BETWEEN -90 AND 90 AND lon BETWEEN -180 AND 180
+);
+
+CREATE INDEX IF NOT EXISTS idx_ads_city ON ads(city) WHERE deleted_at IS NULL AND city <> '';
+CREATE INDEX IF NOT EXISTS idx_ads_region ON ads(region) WHERE deleted_at IS NULL AND region <> '';
+-- Bounding-box prefilter of the radius search
+CREATE INDEX IF NOT EXISTS idx_ads_coordinates ON ads(lat, lon) WHERE deleted_at IS NULL AND lat IS NOT NULL;
+
+-- Great-circle distance in km (haversine); the same formula as geo.DistanceKm
+CREATE OR REPLACE FUNCTION ad_distance_km(lat1 DOUBLE PRECISION, lon1 DOUBLE PRECISION, lat2 DOUBLE PRECISION, lon2 DOUBLE PRECISION)
+RETURNS DOUBLE PRECISION
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT 2 * 6371.0 * asin(least(1, sqrt(
+        sin(radians(lat2 - lat1) / 2) ^ 2 +
+        cos(radians(lat1)) * cos(radians(lat2)) * sin(radians(lon2 - lon1) / 2) ^ 2
+    )))
+$$;
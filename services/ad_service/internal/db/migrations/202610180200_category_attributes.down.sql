@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ads_attributes;
+ALTER TABLE ads DROP COLUMN IF EXISTS attributes;
+DROP TABLE IF EXISTS category_attributes;
@@ -0,0 +1,16 @@
+-- Attribute schema of a category; subcategories inherit their ancestors' attributes
+CREATE TABLE IF NOT EXISTS category_attributes (
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('enum', 'number', 'bool', 'text')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options TEXT[] NOT NULL DEFAULT '{}', -- allowed values of an enum
+    position INT NOT NULL,
+    PRIMARY KEY (category_id, key)
+);
+
+-- Attribute values of an ad as a flat object of strings, validated against the category schema
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+-- Equality filters use containment (attributes @> '{"brand": "Apple"}')
+CREATE INDEX IF NOT EXISTS idx_ads_attributes ON ads USING GIN (attributes jsonb_path_ops) WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS balance_ledger;
+DROP TABLE IF EXISTS balances;
+DROP INDEX IF EXISTS idx_ads_promoted_until;
+DROP INDEX IF EXISTS idx_ads_newest;
+CREATE INDEX IF NOT EXISTS idx_ads_created_id ON ads(created_at DESC, id DESC);
+ALTER TABLE ads
+    DROP COLUMN IF EXISTS promoted_until,
+    DROP COLUMN IF EXISTS promoted,
+    DROP COLUMN IF EXISTS bumped_at;
@@ -0,0 +1,35 @@
+-- Ranking timestamp of the default "newest" order: starts at created_at and moves forward on BumpAd
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS bumped_at TIMESTAMPTZ;
+UPDATE ads SET bumped_at = created_at WHERE bumped_at IS NULL;
+ALTER TABLE ads ALTER COLUMN bumped_at SET DEFAULT NOW();
+ALTER TABLE ads ALTER COLUMN bumped_at SET NOT NULL;
+
+-- Paid promotion. promoted mirrors promoted_until > NOW() and is reset by the expiry worker,
+-- so it can lead the ListAds sort keys and be indexed (an index cannot depend on NOW()).
+ALTER TABLE ads
+    ADD COLUMN IF NOT EXISTS promoted BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS promoted_until TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_ads_created_id;
+CREATE INDEX IF NOT EXISTS idx_ads_newest ON ads(promoted DESC, bumped_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_ads_promoted_until ON ads(promoted_until) WHERE promoted;
+
+-- Internal credits: balances holds the current amount, balance_ledger every change of it
+CREATE TABLE IF NOT EXISTS balances (
+    user_id UUID PRIMARY KEY,
+    amount BIGINT NOT NULL DEFAULT 0 CONSTRAINT chk_balances_non_negative CHECK (amount >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS balance_ledger (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount <> 0), -- positive for credits, negative for charges
+    reason TEXT NOT NULL CHECK (reason IN ('top_up', 'promotion')),
+    ad_id UUID REFERENCES ads(id) ON DELETE SET NULL,
+    actor_id UUID, -- who made the change: the admin for top-ups, the owner for charges
+    comment TEXT NOT NULL DEFAULT '',
+    balance_after BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_balance_ledger_user ON balance_ledger(user_id, created_at DESC, id DESC);
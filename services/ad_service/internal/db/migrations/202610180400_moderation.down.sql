@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS moderation_audit;
+DROP TABLE IF EXISTS ad_reports;
+ALTER TABLE ads DROP COLUMN IF EXISTS hidden_at;
@@ -0,0 +1,31 @@
+-- Set while a moderator (or the report threshold) keeps the ad out of public listings
+ALTER TABLE ads ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;
+
+-- Moderation queue: user reports move OPEN -> IN_REVIEW (ad hidden by the threshold) -> RESOLVED
+CREATE TABLE IF NOT EXISTS ad_reports (
+    id UUID PRIMARY KEY,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    reporter_id UUID NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('scam', 'prohibited', 'spam', 'offensive', 'other')),
+    comment TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'IN_REVIEW', 'RESOLVED')),
+    resolution TEXT NOT NULL DEFAULT '' CHECK (resolution IN ('', 'ad_hidden', 'ad_restored', 'rejected')),
+    reviewer_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ
+);
+-- one pending report per user and ad; after resolution the user may report again
+CREATE UNIQUE INDEX IF NOT EXISTS uq_ad_reports_pending ON ad_reports(ad_id, reporter_id) WHERE status <> 'RESOLVED';
+CREATE INDEX IF NOT EXISTS idx_ad_reports_queue ON ad_reports(status, created_at, id);
+
+-- Audit trail of reports and moderator decisions; kept after the ad is purged
+CREATE TABLE IF NOT EXISTS moderation_audit (
+    id UUID PRIMARY KEY,
+    ad_id UUID NOT NULL,
+    report_id UUID,
+    actor_id UUID, -- NULL for automatic hiding
+    action TEXT NOT NULL CHECK (action IN ('report', 'auto_hide', 'hide', 'unhide', 'reject')),
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_moderation_audit_ad ON moderation_audit(ad_id, created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_ads_status_oldest;
+DROP INDEX IF EXISTS idx_ads_status_newest;
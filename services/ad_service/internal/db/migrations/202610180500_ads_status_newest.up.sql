@@ -0,0 +1,6 @@
+-- Public listing filters by status and pins promoted ads first. The default order is
+-- (promoted, bumped_at, id) within a status; sort=oldest is (NOT promoted, created_at, id)
+-- (see searchOrder.pinned). idx_ads_status_created_id is kept for status-filtered
+-- queries ordered by created_at without pinning.
+CREATE INDEX IF NOT EXISTS idx_ads_status_newest ON ads(status, promoted DESC, bumped_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_ads_status_oldest ON ads(status, (NOT promoted), created_at, id);
@@ -0,0 +1,70 @@
+// Package events содержит реализации service.Publisher для доставки событий из outbox.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// LogPublisher пишет каждое событие строкой в стандартный лог.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(ctx context.Context, ev model.AdEvent) error {
+	log.Printf("event %s %s ad=%s actor=%s payload=%s", ev.ID, ev.Type, ev.AdID, ev.ActorID, ev.Payload)
+	return nil
+}
+
+// MemoryPublisher складывает события в память; удобен в тестах и при отладке.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []model.AdEvent
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, ev model.AdEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, ev)
+	return nil
+}
+
+// Events возвращает копию полученных событий.
+func (p *MemoryPublisher) Events() []model.AdEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]model.AdEvent(nil), p.events...)
+}
+
+// FilePublisher дописывает события в файл в формате JSON Lines — по объекту на строку.
+// Каждая запись сбрасывается на диск до того, как событие считается доставленным.
+type FilePublisher struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// NewFilePublisher открывает (или создаёт) файл path на дозапись.
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FilePublisher{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+func (p *FilePublisher) Publish(ctx context.Context, ev model.AdEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.enc.Encode(ev); err != nil {
+		return err
+	}
+	return p.f.Sync()
+}
+
+func (p *FilePublisher) Close() error {
+	return p.f.Close()
+}
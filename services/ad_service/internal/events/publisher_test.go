@@ -0,0 +1,49 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestFilePublisher_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	for i, id := range []string{"e1", "e2"} {
+		// повторное открытие дописывает, а не перезаписывает файл
+		p, err := NewFilePublisher(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := model.AdEvent{ID: id, Type: model.EventAdCreated, AdID: "ad1", Payload: json.RawMessage(fmt.Sprintf(`{"n":%d}`, i))}
+		if err := p.Publish(context.Background(), ev); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+		if err := p.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got []model.AdEvent
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var ev model.AdEvent
+		if err := json.Unmarshal(sc.Bytes(), &ev); err != nil {
+			t.Fatalf("line %q is not JSON: %v", sc.Text(), err)
+		}
+		got = append(got, ev)
+	}
+	if len(got) != 2 || got[0].ID != "e1" || got[1].ID != "e2" || string(got[1].Payload) != `{"n":1}` {
+		t.Errorf("unexpected events %+v", got)
+	}
+}
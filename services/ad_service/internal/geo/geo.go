@@ -0,0 +1,96 @@
+// Package geo — встроенный справочник городов и регионов для проверки местоположения
+// объявлений без обращения к внешним сервисам.
+package geo
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// City — город из справочника; координаты — центр города.
+type City struct {
+	Name   string
+	Region string
+	Lat    float64
+	Lon    float64
+}
+
+//go:embed cities.csv
+var citiesCSV string
+
+// earthRadiusKm — средний радиус Земли; та же константа в ad_distance_km (миграция ad_location).
+const earthRadiusKm = 6371.0
+
+var (
+	cities  = map[string]City{}   // по нормализованному названию
+	regions = map[string]string{} // нормализованное название -> каноническое
+)
+
+func init() {
+	records, err := csv.NewReader(strings.NewReader(citiesCSV)).ReadAll()
+	if err != nil {
+		panic("geo: cities.csv: " + err.Error())
+	}
+	for _, rec := range records[1:] {
+		lat, err1 := strconv.ParseFloat(rec[2], 64)
+		lon, err2 := strconv.ParseFloat(rec[3], 64)
+		if err1 != nil || err2 != nil {
+			panic("geo: cities.csv: bad coordinates for " + rec[0])
+		}
+		c := City{Name: rec[0], Region: rec[1], Lat: lat, Lon: lon}
+		cities[normalize(c.Name)] = c
+		regions[normalize(c.Region)] = c.Region
+	}
+}
+
+// normalize приводит название к виду для сравнения: без регистра, «ё» как «е», одиночные пробелы.
+func normalize(s string) string {
+	s = strings.ReplaceAll(strings.ToLower(s), "ё", "е")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// LookupCity ищет город по названию без учёта регистра и различия «е»/«ё».
+func LookupCity(name string) (City, bool) {
+	c, ok := cities[normalize(name)]
+	return c, ok
+}
+
+// LookupRegion возвращает каноническое название региона.
+func LookupRegion(name string) (string, bool) {
+	r, ok := regions[normalize(name)]
+	return r, ok
+}
+
+// ValidPoint проверяет, что широта и долгота в допустимых пределах.
+func ValidPoint(lat, lon float64) bool {
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+// DistanceKm — расстояние между точками по дуге большого круга (формула гаверсинусов).
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// BoundingBox возвращает границы широты и долготы, в которые заведомо попадают все точки
+// не дальше radiusKm от центра. ok=false, если круг задевает полюс или линию перемены дат —
+// тогда ограничивать долготу нельзя.
+func BoundingBox(lat, lon, radiusKm float64) (minLat, maxLat, minLon, maxLon float64, ok bool) {
+	dLat := radiusKm / earthRadiusKm * 180 / math.Pi
+	minLat, maxLat = lat-dLat, lat+dLat
+	if minLat <= -90 || maxLat >= 90 {
+		return 0, 0, 0, 0, false
+	}
+	dLon := dLat / math.Cos(math.Max(math.Abs(minLat), math.Abs(maxLat))*math.Pi/180)
+	minLon, maxLon = lon-dLon, lon+dLon
+	if minLon < -180 || maxLon > 180 {
+		return 0, 0, 0, 0, false
+	}
+	return minLat, maxLat, minLon, maxLon, true
+}
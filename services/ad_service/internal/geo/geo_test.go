@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLookupCityNormalizes(t *testing.T) {
+	c, ok := LookupCity("  королев ")
+	if !ok || c.Name != "Королёв" || c.Region != "Московская область" {
+		t.Fatalf("got %+v, %v", c, ok)
+	}
+	if _, ok := LookupCity("Атлантида"); ok {
+		t.Error("unknown city must not be found")
+	}
+	if r, ok := LookupRegion("республика  татарстан"); !ok || r != "Республика Татарстан" {
+		t.Errorf("got %q, %v", r, ok)
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	msk, _ := LookupCity("Москва")
+	spb, _ := LookupCity("Санкт-Петербург")
+	// около 634 км по прямой
+	if d := DistanceKm(msk.Lat, msk.Lon, spb.Lat, spb.Lon); math.Abs(d-634) > 5 {
+		t.Errorf("Moscow–Saint Petersburg: got %.1f km", d)
+	}
+	if d := DistanceKm(msk.Lat, msk.Lon, msk.Lat, msk.Lon); d != 0 {
+		t.Errorf("same point: got %v", d)
+	}
+}
+
+func TestBoundingBoxContainsCircle(t *testing.T) {
+	const r = 50
+	minLat, maxLat, minLon, maxLon, ok := BoundingBox(55.75, 37.62, r)
+	if !ok {
+		t.Fatal("expected a box")
+	}
+	// точки на границе круга по сторонам света лежат внутри прямоугольника
+	for _, p := range [][2]float64{{minLat, 37.62}, {maxLat, 37.62}, {55.75, minLon}, {55.75, maxLon}} {
+		if d := DistanceKm(55.75, 37.62, p[0], p[1]); d < r-0.5 {
+			t.Errorf("box edge %v is only %.1f km away", p, d)
+		}
+	}
+	if _, _, _, _, ok := BoundingBox(64.73, 179.9, r); ok {
+		t.Error("box crossing the antimeridian must be rejected")
+	}
+}
@@ -12,10 +12,44 @@ type Ad struct {
 	Description        string
 	Price              int64
 	CategoryID         string
-	Condition          string // NEW, USED, REFURBISHED
-	Status             string // ACTIVE, ARCHIVED
+	Condition          string // одна из констант Condition*
+	Status             string // одна из констант Status*
 	SellerRatingCached *float64
+	SellerReviewCount  int
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
+	ExpiresAt          time.Time  // после этого момента активное объявление уходит в архив
+	DeletedAt          *time.Time // nil, пока объявление не удалено; удалённые не видны в выдаче
+	BumpedAt           time.Time  // время для сортировки newest: создание или последнее поднятие
+	PromotedUntil      *time.Time // конец оплаченного продвижения; nil — не продвигалось
+	HiddenAt           *time.Time // скрыто модерацией; такое объявление видят только владелец и администраторы
 	Images             []AdImage
+	Location           Location
+	Attributes         map[string]string // характеристики по схеме категории, значения в каноническом виде
+	IsFavorite         bool              // вычисляется для конкретного пользователя, в БД не хранится
+	// Расстояние до точки фильтра near в ListAds, км; nil без фильтра или у объявлений без координат.
+	DistanceKm *float64
+	// Фрагменты с подсветкой (<mark>) совпадений полнотекстового поиска, HTML-экранированы.
+	TitleHighlight       string
+	DescriptionHighlight string
 }
+
+// Promoted сообщает, продвигается ли объявление в момент now.
+func (a *Ad) Promoted(now time.Time) bool {
+	return a.PromotedUntil != nil && a.PromotedUntil.After(now)
+}
+
+// Статусы объявления (ads.status). Допустимые переходы описаны в service.
+const (
+	StatusActive   = "ACTIVE"
+	StatusInactive = "INACTIVE"
+	StatusSold     = "SOLD"
+	StatusArchived = "ARCHIVED"
+)
+
+// Состояние товара (ads.condition).
+const (
+	ConditionNew         = "NEW"
+	ConditionUsed        = "USED"
+	ConditionRefurbished = "REFURBISHED"
+)
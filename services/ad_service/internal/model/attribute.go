@@ -0,0 +1,29 @@
+package model
+
+// Типы характеристик (category_attributes.type).
+const (
+	AttributeEnum   = "enum"
+	AttributeNumber = "number"
+	AttributeBool   = "bool"
+	AttributeText   = "text"
+)
+
+// AttributeDef — характеристика в схеме категории (марка, объём памяти, пробег и т.п.).
+type AttributeDef struct {
+	CategoryID string // категория, в которой характеристика объявлена; подкатегории её наследуют
+	Key        string
+	Name       string
+	Type       string   // одна из констант Attribute*
+	Required   bool     // объявление без значения не принимается
+	Options    []string // допустимые значения enum
+	Position   int      // порядок в форме, с 1
+}
+
+// AttributeFilter — фильтр ListAds по характеристике: равенство для enum, bool и text,
+// диапазон (границы включительно) для number.
+type AttributeFilter struct {
+	Key    string
+	Equals *string
+	Min    *float64
+	Max    *float64
+}
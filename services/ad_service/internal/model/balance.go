@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Причины движения по внутреннему балансу (balance_ledger.reason).
+const (
+	LedgerTopUp     = "top_up"    // начисление администратором
+	LedgerPromotion = "promotion" // оплата продвижения объявления
+)
+
+// LedgerEntry — одно изменение баланса пользователя.
+type LedgerEntry struct {
+	ID           string
+	UserID       string
+	Amount       int64 // положительное — начисление, отрицательное — списание
+	Reason       string
+	AdID         *string // объявление, за продвижение которого списано
+	ActorID      string
+	Comment      string
+	BalanceAfter int64
+	CreatedAt    time.Time
+}
@@ -1,7 +1,12 @@
 package model
 
 type Category struct {
-	ID   string
-	Slug string
-	Name string
+	ID       string
+	Slug     string
+	Name     string
+	ParentID *string // nil для корневых категорий
+	Archived bool
+	// AdLifetimeDays — срок жизни объявлений в категории; nil — как у родителя или по умолчанию.
+	AdLifetimeDays *int
+	Children       []Category // заполняется при построении дерева, в БД не хранится
 }
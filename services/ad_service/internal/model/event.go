@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AdEvent — доменное событие из outbox-таблицы ad_events. ID стабилен между
+// повторными доставками, по нему потребители отбрасывают дубли.
+type AdEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"` // одна из констант Event*
+	AdID      string          `json:"ad_id"`
+	ActorID   string          `json:"actor_id,omitempty"` // пусто для системных изменений
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// Типы событий жизненного цикла объявления.
+const (
+	EventAdCreated       = "ad.created"
+	EventAdUpdated       = "ad.updated"
+	EventAdDeleted       = "ad.deleted"
+	EventAdRestored      = "ad.restored"
+	EventAdStatusChanged = "ad.status_changed"
+	EventAdImagesChanged = "ad.images_changed"
+	EventAdPromoted      = "ad.promoted"
+	EventAdBumped        = "ad.bumped"
+	EventAdHidden        = "ad.hidden"
+	EventAdUnhidden      = "ad.unhidden"
+)
@@ -0,0 +1,22 @@
+package model
+
+// Location — местоположение объявления: город и/или регион из справочника (internal/geo)
+// и необязательные координаты. Пустое значение — местоположение не указано.
+type Location struct {
+	City   string   `json:"city,omitempty"`
+	Region string   `json:"region,omitempty"`
+	Lat    *float64 `json:"lat,omitempty"` // заданы вместе с Lon или не заданы оба
+	Lon    *float64 `json:"lon,omitempty"`
+}
+
+// IsZero сообщает, что местоположение не указано.
+func (l Location) IsZero() bool {
+	return l.City == "" && l.Region == "" && l.Lat == nil && l.Lon == nil
+}
+
+// GeoFilter — поиск в радиусе RadiusKm от точки.
+type GeoFilter struct {
+	Lat      float64
+	Lon      float64
+	RadiusKm float64
+}
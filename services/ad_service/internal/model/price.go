@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// PricePoint — цена объявления, действующая с момента ChangedAt.
+type PricePoint struct {
+	Price     int64
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+// PriceDropNotification — уведомление пользователю, у которого объявление в избранном, о снижении цены.
+type PriceDropNotification struct {
+	ID        string
+	UserID    string
+	AdID      string
+	OldPrice  int64
+	NewPrice  int64
+	CreatedAt time.Time
+}
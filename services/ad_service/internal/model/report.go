@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// Report — жалоба пользователя на объявление в очереди модерации.
+type Report struct {
+	ID         string
+	AdID       string
+	ReporterID string
+	Reason     string // одна из констант Reason*
+	Comment    string
+	Status     string // одна из констант Report*
+	Resolution string // одна из констант Resolution*; пусто, пока жалоба не рассмотрена
+	ReviewerID string // модератор, закрывший жалобу
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+// Состояния жалобы (ad_reports.status).
+const (
+	ReportOpen     = "OPEN"
+	ReportInReview = "IN_REVIEW" // объявление скрыто автоматически и ждёт решения модератора
+	ReportResolved = "RESOLVED"
+)
+
+// Причины жалобы.
+const (
+	ReasonScam       = "scam"
+	ReasonProhibited = "prohibited"
+	ReasonSpam       = "spam"
+	ReasonOffensive  = "offensive"
+	ReasonOther      = "other"
+)
+
+// Итоги рассмотрения жалобы.
+const (
+	ResolutionHidden   = "ad_hidden"   // модератор скрыл объявление
+	ResolutionRestored = "ad_restored" // модератор вернул объявление в выдачу
+	ResolutionRejected = "rejected"    // жалоба отклонена
+)
+
+// ModerationAction — запись журнала модерации.
+type ModerationAction struct {
+	ID        string
+	AdID      string
+	ReportID  *string
+	ActorID   string // пусто для автоматического скрытия
+	Action    string // одна из констант Action*
+	Comment   string
+	CreatedAt time.Time
+}
+
+// Действия журнала модерации (moderation_audit.action).
+const (
+	ActionReport   = "report"
+	ActionAutoHide = "auto_hide"
+	ActionHide     = "hide"
+	ActionUnhide   = "unhide"
+	ActionReject   = "reject"
+)
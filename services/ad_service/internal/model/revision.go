@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// AdRevision — одна правка объявления (UpdateAd или ReplaceImages) с пополевым диффом.
+type AdRevision struct {
+	ID        string
+	AdID      string
+	Revision  int // порядковый номер правки в пределах объявления, с 1
+	ActorID   string
+	Changes   []FieldChange
+	CreatedAt time.Time
+}
+
+// FieldChange — старое и новое значение поля в текстовом виде.
+// Для изображений значения — URL через перевод строки.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// SavedSearch — сохранённые пользователем фильтры ListAds.
+type SavedSearch struct {
+	ID                   string
+	UserID               string
+	Name                 string
+	Text                 string
+	CategoryID           *string // id категории (slug разрешается при сохранении)
+	IncludeSubcategories bool
+	PriceMin             *int64
+	PriceMax             *int64
+	Condition            *string
+	NewMatches           int       // совпадений с момента LastSeenAt
+	LastSeenAt           time.Time // когда владелец последний раз смотрел новые совпадения
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// Счётчики статистики объявления, кроме просмотров.
+const (
+	StatFavorites      = "favorites"
+	StatContactReveals = "contact_reveals"
+)
+
+// AdView — просмотр карточки объявления, ещё не записанный в базу.
+type AdView struct {
+	AdID      string
+	ViewerKey string // id пользователя или "anon:<client id>"
+	At        time.Time
+}
+
+// AdStatDelta — прирост одного счётчика (Stat*) объявления за день.
+type AdStatDelta struct {
+	AdID string
+	Day  time.Time // полночь UTC
+	Kind string
+	N    int
+}
+
+// AdDailyStats — счётчики объявления за один день (UTC).
+type AdDailyStats struct {
+	Day            time.Time
+	Views          int
+	Favorites      int
+	ContactReveals int
+}
+
+// AdStatsSummary — сумма счётчиков объявления за период, строка панели продавца.
+type AdStatsSummary struct {
+	AdID           string
+	Title          string
+	Status         string
+	Views          int
+	Favorites      int
+	ContactReveals int
+}
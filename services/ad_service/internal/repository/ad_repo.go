@@ -14,11 +14,11 @@ import (
 )
 
 type AdRepository struct {
-	pool *pgxpool.Pool
+	db dbtx // *pgxpool.Pool, or pgx.Tx inside InTx
 }
 
 func NewAdRepository(pool *pgxpool.Pool) *AdRepository {
-	return &AdRepository{pool: pool}
+	return &AdRepository{db: pool}
 }
 
 func (r *AdRepository) Create(ctx context.Context, ad *model.Ad) error {
@@ -27,26 +27,42 @@ func (r *AdRepository) Create(ctx context.Context, ad *model.Ad) error {
 	}
 	ad.CreatedAt = time.Now()
 	ad.UpdatedAt = ad.CreatedAt
-	_, err := r.pool.Exec(ctx, `INSERT INTO ads (id, author_id, title, description, price, category_id, condition, status, seller_rating_cached, created_at, updated_at)
-	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`,
-		ad.ID, ad.AuthorID, ad.Title, ad.Description, ad.Price, ad.CategoryID, ad.Condition, ad.Status, ad.SellerRatingCached, ad.CreatedAt, ad.UpdatedAt,
+	ad.BumpedAt = ad.CreatedAt
+	_, err := r.db.Exec(ctx, `INSERT INTO ads (id, author_id, title, description, price, category_id, condition, status, seller_rating_cached, seller_review_count, created_at, updated_at, expires_at, city, region, lat, lon, attributes, bumped_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)`,
+		ad.ID, ad.AuthorID, ad.Title, ad.Description, ad.Price, ad.CategoryID, ad.Condition, ad.Status, ad.SellerRatingCached, ad.SellerReviewCount, ad.CreatedAt, ad.UpdatedAt, ad.ExpiresAt,
+		ad.Location.City, ad.Location.Region, ad.Location.Lat, ad.Location.Lon, attributesParam(ad.Attributes), ad.BumpedAt,
 	)
 	return err
 }
 
-func (r *AdRepository) Get(ctx context.Context, id string) (*model.Ad, error) {
-	row := r.pool.QueryRow(ctx, `SELECT id, author_id, title, description, price, category_id, condition, status, seller_rating_cached, created_at, updated_at FROM ads WHERE id=$1`, id)
+// adColumns is the column list expected by scanAd.
+const adColumns = `id, author_id, title, description, price, category_id, condition, status, seller_rating_cached, seller_review_count, created_at, updated_at, expires_at, city, region, lat, lon, attributes, bumped_at, promoted_until, hidden_at`
+
+// scanAd reads a row produced by a SELECT of adColumns followed by optional extra columns.
+func scanAd(row pgx.Row, extra ...any) (model.Ad, error) {
 	var ad model.Ad
 	var rating *float64
-	if err := row.Scan(&ad.ID, &ad.AuthorID, &ad.Title, &ad.Description, &ad.Price, &ad.CategoryID, &ad.Condition, &ad.Status, &rating, &ad.CreatedAt, &ad.UpdatedAt); err != nil {
-		return nil, err
+	dest := []any{&ad.ID, &ad.AuthorID, &ad.Title, &ad.Description, &ad.Price, &ad.CategoryID, &ad.Condition, &ad.Status, &rating, &ad.SellerReviewCount, &ad.CreatedAt, &ad.UpdatedAt, &ad.ExpiresAt,
+		&ad.Location.City, &ad.Location.Region, &ad.Location.Lat, &ad.Location.Lon, &ad.Attributes, &ad.BumpedAt, &ad.PromotedUntil, &ad.HiddenAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return model.Ad{}, err
 	}
 	ad.SellerRatingCached = rating
+	return ad, nil
+}
+
+func (r *AdRepository) Get(ctx context.Context, id string) (*model.Ad, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+adColumns+` FROM ads WHERE id=$1 AND deleted_at IS NULL`, id)
+	ad, err := scanAd(row)
+	if err != nil {
+		return nil, err
+	}
 	return &ad, nil
 }
 
 func (r *AdRepository) ListImages(ctx context.Context, adID string) ([]model.AdImage, error) {
-	rows, err := r.pool.Query(ctx, `SELECT id, ad_id, url, is_primary, position FROM ad_images WHERE ad_id=$1 ORDER BY position ASC, id ASC`, adID)
+	rows, err := r.db.Query(ctx, `SELECT id, ad_id, url, is_primary, position FROM ad_images WHERE ad_id=$1 ORDER BY position ASC, id ASC`, adID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,55 +78,31 @@ func (r *AdRepository) ListImages(ctx context.Context, adID string) ([]model.AdI
 	return images, nil
 }
 
-func (r *AdRepository) Search(ctx context.Context, text string, categoryID *string, priceMin, priceMax *int64, condition *string, limit, offset int) ([]model.Ad, int, error) {
-	// Simplified search (will extend later with proper builder)
-	query := `SELECT id, author_id, title, description, price, category_id, condition, status, seller_rating_cached, created_at, updated_at FROM ads WHERE 1=1`
-	args := []any{}
-	idx := 1
-	appendCond := func(cond string, val any) {
-		query += fmt.Sprintf(" AND %s $%d", cond, idx)
-		args = append(args, val)
-		idx++
-	}
-	if text != "" {
-		query += fmt.Sprintf(" AND (title ILIKE $%d OR description ILIKE $%d)", idx, idx)
-		args = append(args, "%"+text+"%")
-		idx++
-	}
-	if categoryID != nil {
-		appendCond("category_id =", *categoryID)
-	}
-	if priceMin != nil {
-		appendCond("price >=", *priceMin)
-	}
-	if priceMax != nil {
-		appendCond("price <=", *priceMax)
-	}
-	if condition != nil {
-		appendCond("condition =", *condition)
-	}
-	// Pagination
-	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", idx, idx+1)
-	args = append(args, limit, offset)
-	rows, err := r.pool.Query(ctx, query, args...)
+// ListImagesByAds loads images of several ads in one query, grouped by ad id
+// and ordered like ListImages. Ads without images are absent from the map.
+func (r *AdRepository) ListImagesByAds(ctx context.Context, adIDs []string) (map[string][]model.AdImage, error) {
+	res := make(map[string][]model.AdImage, len(adIDs))
+	if len(adIDs) == 0 {
+		return res, nil
+	}
+	rows, err := r.db.Query(ctx, `SELECT id, ad_id, url, is_primary, position FROM ad_images WHERE ad_id = ANY($1) ORDER BY ad_id, position ASC, id ASC`, adIDs)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
-	var list []model.Ad
 	for rows.Next() {
-		var ad model.Ad
-		var rating *float64
-		if err := rows.Scan(&ad.ID, &ad.AuthorID, &ad.Title, &ad.Description, &ad.Price, &ad.CategoryID, &ad.Condition, &ad.Status, &rating, &ad.CreatedAt, &ad.UpdatedAt); err != nil {
-			return nil, 0, err
+		var img model.AdImage
+		if err := rows.Scan(&img.ID, &img.AdID, &img.URL, &img.IsPrimary, &img.Position); err != nil {
+			return nil, err
 		}
-		ad.SellerRatingCached = rating
-		list = append(list, ad)
+		res[img.AdID] = append(res[img.AdID], img)
 	}
-	return list, len(list), nil
+	return res, rows.Err()
 }
 
-func (r *AdRepository) Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition, status *string) error {
+// Update changes the ad's editable fields; status goes through ChangeStatus.
+// A non-nil loc replaces the whole location and a non-nil attrs all attribute values.
+func (r *AdRepository) Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition *string, loc *model.Location, attrs map[string]string) error {
 	set := "updated_at = NOW()"
 	args := []any{}
 	idx := 1
@@ -134,13 +126,19 @@ func (r *AdRepository) Update(ctx context.Context, id string, authorID string, t
 	if condition != nil {
 		add("condition =", *condition)
 	}
-	if status != nil {
-		add("status =", *status)
+	if loc != nil {
+		add("city =", loc.City)
+		add("region =", loc.Region)
+		add("lat =", loc.Lat)
+		add("lon =", loc.Lon)
+	}
+	if attrs != nil {
+		add("attributes =", attrs)
 	}
 	// WHERE id and author
-	query := fmt.Sprintf("UPDATE ads SET %s WHERE id = $%d AND author_id = $%d", set, idx, idx+1)
+	query := fmt.Sprintf("UPDATE ads SET %s WHERE id = $%d AND author_id = $%d AND deleted_at IS NULL", set, idx, idx+1)
 	args = append(args, id, authorID)
-	res, err := r.pool.Exec(ctx, query, args...)
+	res, err := r.db.Exec(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -150,52 +148,56 @@ func (r *AdRepository) Update(ctx context.Context, id string, authorID string, t
 	return nil
 }
 
+// AttachMedia appends an image after the ad's last one; the first image of an ad becomes primary.
 func (r *AdRepository) AttachMedia(ctx context.Context, adID, mediaID string) error {
 	// store mediaID as URL for simplicity
 	id := uuid.New().String()
-	_, err := r.pool.Exec(ctx, `INSERT INTO ad_images (id, ad_id, url, is_primary, position) VALUES ($1,$2,$3,false,0)`, id, adID, mediaID)
+	_, err := r.db.Exec(ctx, `INSERT INTO ad_images (id, ad_id, url, is_primary, position)
+	SELECT $1, $2, $3, COUNT(*) = 0, COALESCE(MAX(position), 0) + 1 FROM ad_images WHERE ad_id = $2`, id, adID, mediaID)
 	return err
 }
 
 // DetachMedia removes link between an ad and a single media entry.
+// If the primary image is removed, the first remaining one becomes primary.
 func (r *AdRepository) DetachMedia(ctx context.Context, adID, mediaID string) error {
-	res, err := r.pool.Exec(ctx, `DELETE FROM ad_images WHERE ad_id=$1 AND url=$2`, adID, mediaID)
+	res, err := r.db.Exec(ctx, `DELETE FROM ad_images WHERE ad_id=$1 AND url=$2`, adID, mediaID)
 	if err != nil {
 		return err
 	}
 	if res.RowsAffected() == 0 {
 		return errors.New("image not found for this ad")
 	}
-	return nil
+	_, err = r.db.Exec(ctx, `UPDATE ad_images SET is_primary = TRUE WHERE id = (
+		SELECT id FROM ad_images WHERE ad_id = $1 ORDER BY position, id LIMIT 1
+	) AND NOT EXISTS (SELECT 1 FROM ad_images WHERE ad_id = $1 AND is_primary)`, adID)
+	return err
 }
 
-// ReplaceImages performs full replacement of images for an ad.
+// ReplaceImages performs full replacement of images for an ad in one transaction.
 // Callers are responsible for permission checks (author/admin) before invoking.
 func (r *AdRepository) ReplaceImages(ctx context.Context, adID string, mediaIDs []string) error {
-	batch := &pgx.Batch{}
-	// remove existing images
-	batch.Queue(`DELETE FROM ad_images WHERE ad_id=$1`, adID)
-	// insert new ones in order
-	position := 0
-	for _, mid := range mediaIDs {
-		if mid == "" {
-			continue
-		}
-		position++
-		batch.Queue(`INSERT INTO ad_images (id, ad_id, url, is_primary, position) VALUES ($1,$2,$3,$4,$5)`, uuid.New().String(), adID, mid, position == 1, position)
-	}
-	br := r.pool.SendBatch(ctx, batch)
-	defer br.Close()
-	for i := 0; i < batch.Len(); i++ {
-		if _, err := br.Exec(); err != nil {
-			return err
+	return r.InTx(ctx, func(tx *AdRepository) error {
+		batch := &pgx.Batch{}
+		// remove existing images
+		batch.Queue(`DELETE FROM ad_images WHERE ad_id=$1`, adID)
+		// insert new ones in order
+		position := 0
+		for _, mid := range mediaIDs {
+			if mid == "" {
+				continue
+			}
+			position++
+			batch.Queue(`INSERT INTO ad_images (id, ad_id, url, is_primary, position) VALUES ($1,$2,$3,$4,$5)`, uuid.New().String(), adID, mid, position == 1, position)
 		}
-	}
-	return nil
+		// Close reports the first failed statement
+		return tx.db.SendBatch(ctx, batch).Close()
+	})
 }
 
+// Delete soft-deletes the author's ad: the row and everything attached to it stay
+// until PurgeDeleted, and the ad can be brought back with Restore.
 func (r *AdRepository) Delete(ctx context.Context, id string, authorID string) error {
-	res, err := r.pool.Exec(ctx, `DELETE FROM ads WHERE id=$1 AND author_id=$2`, id, authorID)
+	res, err := r.db.Exec(ctx, `UPDATE ads SET deleted_at = NOW(), updated_at = NOW() WHERE id=$1 AND author_id=$2 AND deleted_at IS NULL`, id, authorID)
 	if err != nil {
 		return err
 	}
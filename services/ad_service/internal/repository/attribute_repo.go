@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ListCategoryAttributes returns the attributes declared directly in the given categories
+// (without inheritance), ordered by category and position.
+func (r *AdRepository) ListCategoryAttributes(ctx context.Context, categoryIDs []string) ([]model.AttributeDef, error) {
+	rows, err := r.db.Query(ctx, `SELECT category_id, key, name, type, required, options, position
+	FROM category_attributes WHERE category_id = ANY($1::uuid[])
+	ORDER BY category_id, position, key`, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.AttributeDef
+	for rows.Next() {
+		var d model.AttributeDef
+		if err := rows.Scan(&d.CategoryID, &d.Key, &d.Name, &d.Type, &d.Required, &d.Options, &d.Position); err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
+
+// ReplaceCategoryAttributes replaces the attributes declared in the category. Values already
+// stored in ads are kept as is.
+func (r *AdRepository) ReplaceCategoryAttributes(ctx context.Context, categoryID string, defs []model.AttributeDef) error {
+	return r.InTx(ctx, func(tx *AdRepository) error {
+		if _, err := tx.db.Exec(ctx, `DELETE FROM category_attributes WHERE category_id=$1`, categoryID); err != nil {
+			return err
+		}
+		for _, d := range defs {
+			options := d.Options
+			if options == nil {
+				options = []string{}
+			}
+			if _, err := tx.db.Exec(ctx, `INSERT INTO category_attributes (category_id, key, name, type, required, options, position)
+			VALUES ($1,$2,$3,$4,$5,$6,$7)`, categoryID, d.Key, d.Name, d.Type, d.Required, options, d.Position); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// attributesParam makes a nil map an empty JSON object: ads.attributes is NOT NULL.
+func attributesParam(attrs map[string]string) map[string]string {
+	if attrs == nil {
+		return map[string]string{}
+	}
+	return attrs
+}
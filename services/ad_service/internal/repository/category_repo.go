@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrCategorySlugExists is returned when another category already uses the slug.
+var ErrCategorySlugExists = errors.New("category slug already exists")
+
+func scanCategory(row pgx.Row) (model.Category, error) {
+	var c model.Category
+	err := row.Scan(&c.ID, &c.Slug, &c.Name, &c.ParentID, &c.Archived, &c.AdLifetimeDays)
+	return c, err
+}
+
+// ListCategories returns all categories ordered by name; archived ones only when includeArchived is set.
+func (r *AdRepository) ListCategories(ctx context.Context, includeArchived bool) ([]model.Category, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, slug, name, parent_id, archived, ad_lifetime_days FROM categories
+	WHERE $1 OR NOT archived
+	ORDER BY name ASC, id ASC`, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// GetCategory looks a category up by id or by slug.
+func (r *AdRepository) GetCategory(ctx context.Context, idOrSlug string) (*model.Category, error) {
+	row := r.db.QueryRow(ctx, `SELECT id, slug, name, parent_id, archived, ad_lifetime_days FROM categories WHERE id::text = $1 OR slug = $1`, idOrSlug)
+	c, err := scanCategory(row)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *AdRepository) CreateCategory(ctx context.Context, c *model.Category) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	_, err := r.db.Exec(ctx, `INSERT INTO categories (id, slug, name, parent_id, archived, ad_lifetime_days) VALUES ($1,$2,$3,$4,$5,$6)`,
+		c.ID, c.Slug, c.Name, c.ParentID, c.Archived, c.AdLifetimeDays)
+	return categoryWriteErr(err)
+}
+
+// UpdateCategory overwrites slug, name, parent and ad lifetime of an existing category.
+func (r *AdRepository) UpdateCategory(ctx context.Context, c *model.Category) error {
+	res, err := r.db.Exec(ctx, `UPDATE categories SET slug=$2, name=$3, parent_id=$4, ad_lifetime_days=$5 WHERE id=$1`, c.ID, c.Slug, c.Name, c.ParentID, c.AdLifetimeDays)
+	if err != nil {
+		return categoryWriteErr(err)
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ArchiveCategory archives the category together with all of its descendants.
+func (r *AdRepository) ArchiveCategory(ctx context.Context, id string) error {
+	res, err := r.db.Exec(ctx, `WITH RECURSIVE tree AS (
+		SELECT id FROM categories WHERE id = $1
+		UNION ALL
+		SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+	)
+	UPDATE categories SET archived = TRUE WHERE id IN (SELECT id FROM tree)`, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func categoryWriteErr(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrCategorySlugExists
+	}
+	return err
+}
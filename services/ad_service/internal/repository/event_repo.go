@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// outboxLockKey keeps ad_events deliverable in seq order. seq is assigned at insert,
+// before commit, so writers hold this lock shared from the insert until their transaction
+// ends, and the relay briefly takes it exclusively to find the seq below which nothing
+// can be committed any more.
+const outboxLockKey int64 = 0x6164_6f75_7462_6f78 // "adoutbox"
+
+// relayLockKey is held by the relay transaction so that only one ad_service replica
+// delivers events at a time.
+const relayLockKey int64 = 0x6164_7265_6c61_7921 // "adrelay!"
+
+// lockOutboxShared must precede every insert into ad_events; db must be a transaction.
+func lockOutboxShared(ctx context.Context, db dbtx) error {
+	_, err := db.Exec(ctx, `SELECT pg_advisory_xact_lock_shared($1)`, outboxLockKey)
+	return err
+}
+
+// InsertEvent appends an event to the ad_events outbox. Call it on the
+// repository passed to InTx so the event commits together with the change.
+func (r *AdRepository) InsertEvent(ctx context.Context, ev *model.AdEvent) error {
+	if err := lockOutboxShared(ctx, r.db); err != nil {
+		return err
+	}
+	if ev.ID == "" {
+		ev.ID = uuid.New().String()
+	}
+	if ev.CreatedAt.IsZero() {
+		ev.CreatedAt = time.Now()
+	}
+	payload := ev.Payload
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	}
+	_, err := r.db.Exec(ctx, `INSERT INTO ad_events (id, ad_id, type, actor_id, payload, created_at)
+	VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)`, ev.ID, ev.AdID, ev.Type, ev.ActorID, payload, ev.CreatedAt)
+	return err
+}
+
+// SettledEventSeq returns the highest seq handed out so far once every transaction that
+// is writing events has finished, so no event with a smaller seq can appear later.
+// New event writers wait while it runs. Call it outside InTx: the exclusive lock is
+// only released when its own transaction ends.
+func (r *AdRepository) SettledEventSeq(ctx context.Context) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, outboxLockKey); err != nil {
+		return 0, err
+	}
+	// sequences are not transactional: last_value covers rolled back inserts too
+	var seq int64
+	if err := tx.QueryRow(ctx, `SELECT COALESCE(pg_sequence_last_value(pg_get_serial_sequence('ad_events', 'seq')::regclass), 0)`).Scan(&seq); err != nil {
+		return 0, err
+	}
+	return seq, tx.Commit(ctx)
+}
+
+// LockPendingEvents returns up to limit unpublished events with seq <= upTo in seq
+// order. It takes the relay lock until the surrounding transaction ends and returns
+// nothing while another replica holds it. Must be called inside InTx.
+func (r *AdRepository) LockPendingEvents(ctx context.Context, upTo int64, limit int) ([]model.AdEvent, error) {
+	var locked bool
+	if err := r.db.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, relayLockKey).Scan(&locked); err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, nil
+	}
+	rows, err := r.db.Query(ctx, `SELECT id, ad_id, type, COALESCE(actor_id::text, ''), payload, created_at
+	FROM ad_events WHERE published_at IS NULL AND seq <= $1
+	ORDER BY seq LIMIT $2`, upTo, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []model.AdEvent
+	for rows.Next() {
+		var ev model.AdEvent
+		if err := rows.Scan(&ev.ID, &ev.AdID, &ev.Type, &ev.ActorID, &ev.Payload, &ev.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+// MarkEventsPublished records that the events were handed to the publisher.
+func (r *AdRepository) MarkEventsPublished(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx, `UPDATE ad_events SET published_at = NOW() WHERE id = ANY($1)`, ids)
+	return err
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// expiryLockKey is the pg advisory lock taken by ArchiveExpired so that only one
+// ad_service replica archives ads at a time.
+const expiryLockKey int64 = 0x6164_6578_7069_7279 // "adexpiry"
+
+// RenewAd sets a new expiry for the ad and makes it ACTIVE again if it was in status from.
+// The status change (if any) is recorded in ad_status_history.
+func (r *AdRepository) RenewAd(ctx context.Context, adID, from string, expiresAt time.Time, actorID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, `UPDATE ads SET status = $3, expires_at = $4, updated_at = NOW() WHERE id = $1 AND status = $2 AND deleted_at IS NULL`,
+		adID, from, model.StatusActive, expiresAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrStatusConflict
+	}
+	if from != model.StatusActive {
+		if err := insertStatusHistory(ctx, tx, adID, from, model.StatusActive, actorID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ArchiveExpired moves up to limit ACTIVE ads whose expires_at is not after now to ARCHIVED,
+// recording history and an ad.status_changed event for each, and returns how many were archived. If another replica holds the expiry lock it returns 0.
+func (r *AdRepository) ArchiveExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, expiryLockKey).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+	if err := lockOutboxShared(ctx, tx); err != nil {
+		return 0, err
+	}
+	res, err := tx.Exec(ctx, `WITH expired AS (
+		SELECT id FROM ads WHERE status = 'ACTIVE' AND expires_at <= $1 AND deleted_at IS NULL
+		ORDER BY expires_at LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	), archived AS (
+		UPDATE ads a SET status = 'ARCHIVED', updated_at = NOW()
+		FROM expired e WHERE a.id = e.id
+		RETURNING a.id
+	), history AS (
+		INSERT INTO ad_status_history (id, ad_id, from_status, to_status)
+		SELECT gen_random_uuid(), id, 'ACTIVE', 'ARCHIVED' FROM archived
+	)
+	INSERT INTO ad_events (id, ad_id, type, payload)
+	SELECT gen_random_uuid(), id, $3, '{"from": "ACTIVE", "to": "ARCHIVED"}' FROM archived`, now, limit, model.EventAdStatusChanged)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrFavoriteNotFound is returned by RemoveFavorite when the ad is not in the user's favorites.
+var ErrFavoriteNotFound = errors.New("favorite not found")
+
+// AddFavorite marks an ad as favorite for the user and reports whether it was
+// not a favorite before. Adding the same ad twice is a no-op.
+func (r *AdRepository) AddFavorite(ctx context.Context, userID, adID string) (bool, error) {
+	res, err := r.db.Exec(ctx, `INSERT INTO favorites (user_id, ad_id, created_at) VALUES ($1,$2,NOW()) ON CONFLICT (user_id, ad_id) DO NOTHING`, userID, adID)
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func (r *AdRepository) RemoveFavorite(ctx context.Context, userID, adID string) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM favorites WHERE user_id=$1 AND ad_id=$2`, userID, adID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrFavoriteNotFound
+	}
+	return nil
+}
+
+// ListFavorites returns the user's favorite ads, most recently added first, and their total count.
+// Soft-deleted and hidden ads are skipped but stay in favorites, so they come back after a restore.
+func (r *AdRepository) ListFavorites(ctx context.Context, userID string, limit, offset int) ([]model.Ad, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM favorites f JOIN ads ON ads.id = f.ad_id
+	WHERE f.user_id=$1 AND ads.deleted_at IS NULL AND ads.hidden_at IS NULL`, userID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+adColumns+`
+	FROM ads JOIN (SELECT ad_id, created_at AS favorited_at FROM favorites WHERE user_id=$1) f ON f.ad_id = ads.id
+	WHERE ads.deleted_at IS NULL AND ads.hidden_at IS NULL
+	ORDER BY f.favorited_at DESC, ads.id DESC
+	LIMIT $2 OFFSET $3`, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var list []model.Ad
+	for rows.Next() {
+		ad, err := scanAd(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, ad)
+	}
+	return list, total, rows.Err()
+}
+
+// FavoriteAdIDs reports which of adIDs are in the user's favorites.
+func (r *AdRepository) FavoriteAdIDs(ctx context.Context, userID string, adIDs []string) (map[string]bool, error) {
+	result := make(map[string]bool, len(adIDs))
+	if len(adIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.Query(ctx, `SELECT ad_id FROM favorites WHERE user_id=$1 AND ad_id = ANY($2)`, userID, adIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		result[id] = true
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ReorderImages sets positions of the ad's images to their 1-based index in
+// imageIDs. Callers pass a permutation of the ad's current image ids.
+func (r *AdRepository) ReorderImages(ctx context.Context, adID string, imageIDs []string) error {
+	_, err := r.db.Exec(ctx, `UPDATE ad_images SET position = array_position($2::uuid[], id) WHERE ad_id = $1`, adID, imageIDs)
+	return err
+}
+
+// SetPrimaryImage makes imageID the only primary image of the ad. Returns
+// pgx.ErrNoRows if the image does not belong to the ad. The old primary is
+// cleared first because uq_ad_images_primary allows one primary per ad; call
+// it inside InTx so a missing image does not leave the ad without one.
+func (r *AdRepository) SetPrimaryImage(ctx context.Context, adID, imageID string) error {
+	if _, err := r.db.Exec(ctx, `UPDATE ad_images SET is_primary = FALSE WHERE ad_id = $1 AND is_primary AND id <> $2`, adID, imageID); err != nil {
+		return err
+	}
+	res, err := r.db.Exec(ctx, `UPDATE ad_images SET is_primary = TRUE WHERE ad_id = $1 AND id = $2`, adID, imageID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
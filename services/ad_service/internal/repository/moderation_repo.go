@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrDuplicateReport is returned by CreateReport when the user already has a pending report on the ad.
+var ErrDuplicateReport = errors.New("ad already reported by this user")
+
+const reportColumns = `id, ad_id, reporter_id, reason, comment, status, resolution, COALESCE(reviewer_id::text, ''), created_at, resolved_at`
+
+func scanReport(row pgx.Row) (model.Report, error) {
+	var rp model.Report
+	err := row.Scan(&rp.ID, &rp.AdID, &rp.ReporterID, &rp.Reason, &rp.Comment, &rp.Status, &rp.Resolution, &rp.ReviewerID, &rp.CreatedAt, &rp.ResolvedAt)
+	return rp, err
+}
+
+// CreateReport stores a report and fills ID and CreatedAt.
+func (r *AdRepository) CreateReport(ctx context.Context, rp *model.Report) error {
+	rp.ID = uuid.New().String()
+	rp.CreatedAt = time.Now()
+	_, err := r.db.Exec(ctx, `INSERT INTO ad_reports (id, ad_id, reporter_id, reason, comment, status, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`, rp.ID, rp.AdID, rp.ReporterID, rp.Reason, rp.Comment, rp.Status, rp.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrDuplicateReport
+	}
+	return err
+}
+
+// CountPendingReporters returns how many distinct users have unresolved reports on the ad.
+func (r *AdRepository) CountPendingReporters(ctx context.Context, adID string) (int, error) {
+	var n int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(DISTINCT reporter_id) FROM ad_reports WHERE ad_id = $1 AND status <> 'RESOLVED'`, adID).Scan(&n)
+	return n, err
+}
+
+// SetAdHidden hides the ad from public listings or returns it there.
+func (r *AdRepository) SetAdHidden(ctx context.Context, adID string, hidden bool) error {
+	_, err := r.db.Exec(ctx, `UPDATE ads SET hidden_at = CASE WHEN $2 THEN COALESCE(hidden_at, NOW()) END, updated_at = NOW()
+	WHERE id = $1 AND deleted_at IS NULL`, adID, hidden)
+	return err
+}
+
+// UpdateAdReports moves the ad's reports in one of the from statuses to status to.
+// resolution and reviewerID are stored when to is RESOLVED. Returns the number of moved reports.
+func (r *AdRepository) UpdateAdReports(ctx context.Context, adID string, from []string, to, resolution, reviewerID string) (int, error) {
+	res, err := r.db.Exec(ctx, `UPDATE ad_reports SET status = $3,
+		resolution = CASE WHEN $3 = 'RESOLVED' THEN $4 ELSE resolution END,
+		reviewer_id = CASE WHEN $3 = 'RESOLVED' THEN NULLIF($5, '')::uuid ELSE reviewer_id END,
+		resolved_at = CASE WHEN $3 = 'RESOLVED' THEN NOW() ELSE resolved_at END
+	WHERE ad_id = $1 AND status = ANY($2)`, adID, from, to, resolution, reviewerID)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
+
+// GetReportForUpdate returns the report with a row lock held until the surrounding
+// transaction ends. Must be called inside InTx.
+func (r *AdRepository) GetReportForUpdate(ctx context.Context, id string) (*model.Report, error) {
+	rp, err := scanReport(r.db.QueryRow(ctx, `SELECT `+reportColumns+` FROM ad_reports WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+// ResolveReport closes one report with the given resolution.
+func (r *AdRepository) ResolveReport(ctx context.Context, id, resolution, reviewerID string) error {
+	_, err := r.db.Exec(ctx, `UPDATE ad_reports SET status = 'RESOLVED', resolution = $2, reviewer_id = NULLIF($3, '')::uuid, resolved_at = NOW()
+	WHERE id = $1`, id, resolution, reviewerID)
+	return err
+}
+
+// ListReports returns reports in the given status (any when empty) and of the given ad
+// (any when empty), oldest first so the queue is worked in arrival order, and their total count.
+func (r *AdRepository) ListReports(ctx context.Context, status, adID string, limit, offset int) ([]model.Report, int, error) {
+	where := `TRUE`
+	args := []any{}
+	if status != "" {
+		args = append(args, status)
+		where += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	if adID != "" {
+		args = append(args, adID)
+		where += fmt.Sprintf(" AND ad_id = $%d", len(args))
+	}
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM ad_reports WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	query := fmt.Sprintf(`SELECT %s FROM ad_reports WHERE %s ORDER BY created_at, id LIMIT $%d OFFSET $%d`,
+		reportColumns, where, len(args)+1, len(args)+2)
+	rows, err := r.db.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var list []model.Report
+	for rows.Next() {
+		rp, err := scanReport(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, rp)
+	}
+	return list, total, rows.Err()
+}
+
+// InsertModerationAction appends a record to the moderation audit trail and fills ID and CreatedAt.
+func (r *AdRepository) InsertModerationAction(ctx context.Context, a *model.ModerationAction) error {
+	a.ID = uuid.New().String()
+	a.CreatedAt = time.Now()
+	_, err := r.db.Exec(ctx, `INSERT INTO moderation_audit (id, ad_id, report_id, actor_id, action, comment, created_at)
+	VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6, $7)`, a.ID, a.AdID, a.ReportID, a.ActorID, a.Action, a.Comment, a.CreatedAt)
+	return err
+}
+
+// ListModerationActions returns the ad's audit trail, newest first, and its total count.
+func (r *AdRepository) ListModerationActions(ctx context.Context, adID string, limit, offset int) ([]model.ModerationAction, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM moderation_audit WHERE ad_id = $1`, adID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT id, ad_id, report_id::text, COALESCE(actor_id::text, ''), action, comment, created_at
+	FROM moderation_audit WHERE ad_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`, adID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var list []model.ModerationAction
+	for rows.Next() {
+		var a model.ModerationAction
+		if err := rows.Scan(&a.ID, &a.AdID, &a.ReportID, &a.ActorID, &a.Action, &a.Comment, &a.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		list = append(list, a)
+	}
+	return list, total, rows.Err()
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// InsertPricePoint appends the ad's current price to ad_price_history.
+func (r *AdRepository) InsertPricePoint(ctx context.Context, adID string, price int64, actorID string) error {
+	_, err := r.db.Exec(ctx, `INSERT INTO ad_price_history (id, ad_id, price, changed_by) VALUES (gen_random_uuid(), $1, $2, NULLIF($3, '')::uuid)`, adID, price, actorID)
+	return err
+}
+
+// ListPriceHistory returns the ad's price points, oldest first.
+func (r *AdRepository) ListPriceHistory(ctx context.Context, adID string) ([]model.PricePoint, error) {
+	rows, err := r.db.Query(ctx, `SELECT price, COALESCE(changed_by::text, ''), changed_at FROM ad_price_history
+	WHERE ad_id=$1 ORDER BY changed_at ASC, id ASC`, adID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var points []model.PricePoint
+	for rows.Next() {
+		var p model.PricePoint
+		if err := rows.Scan(&p.Price, &p.ChangedBy, &p.ChangedAt); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+// NotifyPriceDrop creates a price_drop_notifications row for every user who has
+// the ad in favorites, except the author, and returns how many were created.
+func (r *AdRepository) NotifyPriceDrop(ctx context.Context, adID string, oldPrice, newPrice int64) (int, error) {
+	res, err := r.db.Exec(ctx, `INSERT INTO price_drop_notifications (id, user_id, ad_id, old_price, new_price)
+	SELECT gen_random_uuid(), f.user_id, f.ad_id, $2, $3 FROM favorites f JOIN ads ON ads.id = f.ad_id
+	WHERE f.ad_id = $1 AND f.user_id <> ads.author_id`, adID, oldPrice, newPrice)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrInsufficientFunds is returned by AddLedgerEntry when a charge would make the balance negative.
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// AddLedgerEntry applies e.Amount to the user's balance and records it in balance_ledger,
+// filling ID, BalanceAfter and CreatedAt. Must be called inside InTx so that a failed
+// ledger insert does not leave the balance changed.
+func (r *AdRepository) AddLedgerEntry(ctx context.Context, e *model.LedgerEntry) error {
+	e.ID = uuid.New().String()
+	e.CreatedAt = time.Now()
+	err := r.db.QueryRow(ctx, `INSERT INTO balances (user_id, amount, updated_at) VALUES ($1, $2, $3)
+	ON CONFLICT (user_id) DO UPDATE SET amount = balances.amount + EXCLUDED.amount, updated_at = EXCLUDED.updated_at
+	RETURNING amount`, e.UserID, e.Amount, e.CreatedAt).Scan(&e.BalanceAfter)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23514" {
+		return ErrInsufficientFunds
+	}
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(ctx, `INSERT INTO balance_ledger (id, user_id, amount, reason, ad_id, actor_id, comment, balance_after, created_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7, $8, $9)`,
+		e.ID, e.UserID, e.Amount, e.Reason, e.AdID, e.ActorID, e.Comment, e.BalanceAfter, e.CreatedAt)
+	return err
+}
+
+// GetBalance returns the user's current balance; users without ledger entries have 0.
+func (r *AdRepository) GetBalance(ctx context.Context, userID string) (int64, error) {
+	var amount int64
+	err := r.db.QueryRow(ctx, `SELECT amount FROM balances WHERE user_id = $1`, userID).Scan(&amount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return amount, err
+}
+
+// ListLedger returns the user's ledger entries, newest first, and their total count.
+func (r *AdRepository) ListLedger(ctx context.Context, userID string, limit, offset int) ([]model.LedgerEntry, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM balance_ledger WHERE user_id = $1`, userID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT id, user_id, amount, reason, ad_id::text, COALESCE(actor_id::text, ''), comment, balance_after, created_at
+	FROM balance_ledger WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var res []model.LedgerEntry
+	for rows.Next() {
+		var e model.LedgerEntry
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Amount, &e.Reason, &e.AdID, &e.ActorID, &e.Comment, &e.BalanceAfter, &e.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		res = append(res, e)
+	}
+	return res, total, rows.Err()
+}
+
+// SetPromotion marks the ad as promoted until the given time.
+func (r *AdRepository) SetPromotion(ctx context.Context, adID string, until time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE ads SET promoted = TRUE, promoted_until = $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, adID, until)
+	return err
+}
+
+// BumpAd moves the ad's bumped_at, which the newest sort order uses, to at.
+func (r *AdRepository) BumpAd(ctx context.Context, adID string, at time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE ads SET bumped_at = $2 WHERE id = $1 AND deleted_at IS NULL`, adID, at)
+	return err
+}
+
+// ExpirePromotions clears the promoted flag of up to limit ads whose promotion ended
+// by now and returns how many were updated.
+func (r *AdRepository) ExpirePromotions(ctx context.Context, now time.Time, limit int) (int, error) {
+	res, err := r.db.Exec(ctx, `UPDATE ads SET promoted = FALSE WHERE id IN (
+		SELECT id FROM ads WHERE promoted AND promoted_until <= $1
+		ORDER BY promoted_until LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)`, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
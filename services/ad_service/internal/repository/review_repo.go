@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrReviewExists is returned when the reviewer has already reviewed the ad.
+var ErrReviewExists = errors.New("review for this ad already exists")
+
+// recomputeSellerRating stores the seller's average rating and review count on all of the seller's ads.
+const recomputeSellerRating = `UPDATE ads SET seller_rating_cached = s.avg_rating, seller_review_count = s.cnt
+FROM (
+	SELECT AVG(r.rating)::DOUBLE PRECISION AS avg_rating, COUNT(r.id)::INT AS cnt
+	FROM ad_reviews r JOIN ads a ON a.id = r.ad_id
+	WHERE a.author_id = $1
+) s
+WHERE ads.author_id = $1`
+
+// sellerLockSpace is the first key of the per-seller advisory locks; the second is hashtext(seller_id).
+const sellerLockSpace int32 = 0x72617465 // "rate"
+
+// lockSeller serializes rating updates of one seller until the transaction ends, so that
+// concurrent reviews and new ads never store an aggregate computed from a stale snapshot.
+func lockSeller(ctx context.Context, db dbtx, sellerID string) error {
+	_, err := db.Exec(ctx, `SELECT pg_advisory_xact_lock($1, hashtext($2))`, sellerLockSpace, sellerID)
+	return err
+}
+
+// refreshSellerRating recomputes the seller's cached rating under the seller lock.
+// db must be a transaction, otherwise the lock is released right away.
+func refreshSellerRating(ctx context.Context, db dbtx, sellerID string) error {
+	if err := lockSeller(ctx, db, sellerID); err != nil {
+		return err
+	}
+	_, err := db.Exec(ctx, recomputeSellerRating, sellerID)
+	return err
+}
+
+// SellerRating returns the seller's current average rating (nil without reviews) and review count.
+// It takes the seller lock, so inside InTx an ad created afterwards stays consistent with
+// reviews committed concurrently.
+func (r *AdRepository) SellerRating(ctx context.Context, sellerID string) (*float64, int, error) {
+	if err := lockSeller(ctx, r.db, sellerID); err != nil {
+		return nil, 0, err
+	}
+	var rating *float64
+	var count int
+	err := r.db.QueryRow(ctx, `SELECT AVG(r.rating)::DOUBLE PRECISION, COUNT(r.id)::INT
+	FROM ad_reviews r JOIN ads a ON a.id = r.ad_id
+	WHERE a.author_id = $1`, sellerID).Scan(&rating, &count)
+	return rating, count, err
+}
+
+const reviewColumns = `r.id, r.ad_id, r.reviewer_id, r.rating, r.comment, r.created_at`
+
+func scanReview(row pgx.Row) (model.Review, error) {
+	var rv model.Review
+	err := row.Scan(&rv.ID, &rv.AdID, &rv.ReviewerID, &rv.Rating, &rv.Comment, &rv.CreatedAt)
+	return rv, err
+}
+
+// CreateReview inserts a review and refreshes the cached rating of the ad's seller in one transaction.
+func (r *AdRepository) CreateReview(ctx context.Context, rv *model.Review, sellerID string) error {
+	if rv.ID == "" {
+		rv.ID = uuid.New().String()
+	}
+	rv.CreatedAt = time.Now()
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `INSERT INTO ad_reviews (id, ad_id, reviewer_id, rating, comment, created_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+		rv.ID, rv.AdID, rv.ReviewerID, rv.Rating, rv.Comment, rv.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrReviewExists
+		}
+		return err
+	}
+	if err := refreshSellerRating(ctx, tx, sellerID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteReview removes the reviewer's own review and refreshes the seller's cached rating.
+func (r *AdRepository) DeleteReview(ctx context.Context, reviewID, reviewerID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var sellerID string
+	err = tx.QueryRow(ctx, `DELETE FROM ad_reviews r USING ads a
+	WHERE r.id=$1 AND r.reviewer_id=$2 AND a.id = r.ad_id
+	RETURNING a.author_id`, reviewID, reviewerID).Scan(&sellerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("not found or no permission")
+	}
+	if err != nil {
+		return err
+	}
+	if err := refreshSellerRating(ctx, tx, sellerID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ListReviewsByAd returns reviews of a single ad, newest first, and their total count.
+func (r *AdRepository) ListReviewsByAd(ctx context.Context, adID string, limit, offset int) ([]model.Review, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM ad_reviews WHERE ad_id=$1`, adID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+reviewColumns+` FROM ad_reviews r WHERE r.ad_id=$1
+	ORDER BY r.created_at DESC, r.id DESC LIMIT $2 OFFSET $3`, adID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return collectReviews(rows, total)
+}
+
+// ListReviewsBySeller returns reviews left on any ad of the seller, newest first, and their total count.
+func (r *AdRepository) ListReviewsBySeller(ctx context.Context, sellerID string, limit, offset int) ([]model.Review, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM ad_reviews r JOIN ads a ON a.id = r.ad_id WHERE a.author_id=$1`, sellerID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+reviewColumns+` FROM ad_reviews r JOIN ads a ON a.id = r.ad_id
+	WHERE a.author_id=$1
+	ORDER BY r.created_at DESC, r.id DESC LIMIT $2 OFFSET $3`, sellerID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return collectReviews(rows, total)
+}
+
+func collectReviews(rows pgx.Rows, total int) ([]model.Review, int, error) {
+	defer rows.Close()
+	var list []model.Review
+	for rows.Next() {
+		rv, err := scanReview(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, rv)
+	}
+	return list, total, rows.Err()
+}
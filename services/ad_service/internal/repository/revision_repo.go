@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// GetForUpdate is Get with a row lock held until the surrounding transaction
+// ends, so concurrent edits of one ad are serialized. Must be called inside InTx.
+func (r *AdRepository) GetForUpdate(ctx context.Context, id string) (*model.Ad, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+adColumns+` FROM ads WHERE id=$1 AND deleted_at IS NULL FOR UPDATE`, id)
+	ad, err := scanAd(row)
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+// InsertRevision stores rev as the next revision of its ad and fills ID, Revision
+// and CreatedAt. Callers lock the ad with GetForUpdate first so numbers do not race.
+func (r *AdRepository) InsertRevision(ctx context.Context, rev *model.AdRevision) error {
+	changes, err := json.Marshal(rev.Changes)
+	if err != nil {
+		return err
+	}
+	rev.ID = uuid.New().String()
+	rev.CreatedAt = time.Now()
+	return r.db.QueryRow(ctx, `INSERT INTO ad_revisions (id, ad_id, revision, actor_id, changes, created_at)
+	SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, NULLIF($3, '')::uuid, $4, $5 FROM ad_revisions WHERE ad_id = $2
+	RETURNING revision`, rev.ID, rev.AdID, rev.ActorID, changes, rev.CreatedAt).Scan(&rev.Revision)
+}
+
+const revisionColumns = `id, ad_id, revision, COALESCE(actor_id::text, ''), changes, created_at`
+
+func scanRevision(row pgx.Row) (model.AdRevision, error) {
+	var rev model.AdRevision
+	var changes []byte
+	if err := row.Scan(&rev.ID, &rev.AdID, &rev.Revision, &rev.ActorID, &changes, &rev.CreatedAt); err != nil {
+		return model.AdRevision{}, err
+	}
+	err := json.Unmarshal(changes, &rev.Changes)
+	return rev, err
+}
+
+// ListRevisions returns the ad's revisions, newest first, and their total count.
+func (r *AdRepository) ListRevisions(ctx context.Context, adID string, limit, offset int) ([]model.AdRevision, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM ad_revisions WHERE ad_id=$1`, adID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+revisionColumns+` FROM ad_revisions WHERE ad_id=$1
+	ORDER BY revision DESC LIMIT $2 OFFSET $3`, adID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var list []model.AdRevision
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, rev)
+	}
+	return list, total, rows.Err()
+}
+
+// GetRevision returns one revision of the ad by its number.
+func (r *AdRepository) GetRevision(ctx context.Context, adID string, revision int) (*model.AdRevision, error) {
+	rev, err := scanRevision(r.db.QueryRow(ctx, `SELECT `+revisionColumns+` FROM ad_revisions WHERE ad_id=$1 AND revision=$2`, adID, revision))
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+const savedSearchColumns = `id, user_id, name, query, category_id::text, include_subcategories, price_min, price_max, condition, new_matches, last_seen_at, created_at, updated_at`
+
+func scanSavedSearch(row pgx.Row) (model.SavedSearch, error) {
+	var s model.SavedSearch
+	err := row.Scan(&s.ID, &s.UserID, &s.Name, &s.Text, &s.CategoryID, &s.IncludeSubcategories, &s.PriceMin, &s.PriceMax, &s.Condition, &s.NewMatches, &s.LastSeenAt, &s.CreatedAt, &s.UpdatedAt)
+	return s, err
+}
+
+// savedSearchLockSpace is the first key of the per-user saved search locks; the second is hashtext(user_id).
+const savedSearchLockSpace int32 = 0x73617665 // "save"
+
+// LockSavedSearches takes the user's saved search lock until the transaction ends and
+// returns how many saved searches the user has, so that concurrent creates cannot
+// exceed a limit checked against it. Must be called inside InTx.
+func (r *AdRepository) LockSavedSearches(ctx context.Context, userID string) (int, error) {
+	if _, err := r.db.Exec(ctx, `SELECT pg_advisory_xact_lock($1, hashtext($2))`, savedSearchLockSpace, userID); err != nil {
+		return 0, err
+	}
+	var n int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM saved_searches WHERE user_id=$1`, userID).Scan(&n)
+	return n, err
+}
+
+// CreateSavedSearch stores s and fills ID and timestamps.
+func (r *AdRepository) CreateSavedSearch(ctx context.Context, s *model.SavedSearch) error {
+	s.ID = uuid.New().String()
+	now := time.Now()
+	s.LastSeenAt, s.CreatedAt, s.UpdatedAt = now, now, now
+	_, err := r.db.Exec(ctx, `INSERT INTO saved_searches (id, user_id, name, query, category_id, include_subcategories, price_min, price_max, condition, last_seen_at, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $10)`,
+		s.ID, s.UserID, s.Name, s.Text, s.CategoryID, s.IncludeSubcategories, s.PriceMin, s.PriceMax, s.Condition, now)
+	return err
+}
+
+// UpdateSavedSearch replaces the name and filters of the user's saved search.
+// Matches found so far are kept. Returns pgx.ErrNoRows if the search is not the user's.
+func (r *AdRepository) UpdateSavedSearch(ctx context.Context, s *model.SavedSearch) error {
+	return r.db.QueryRow(ctx, `UPDATE saved_searches SET name=$3, query=$4, category_id=$5, include_subcategories=$6,
+	price_min=$7, price_max=$8, condition=$9, updated_at=NOW()
+	WHERE id=$1 AND user_id=$2
+	RETURNING new_matches, last_seen_at, created_at, updated_at`,
+		s.ID, s.UserID, s.Name, s.Text, s.CategoryID, s.IncludeSubcategories, s.PriceMin, s.PriceMax, s.Condition,
+	).Scan(&s.NewMatches, &s.LastSeenAt, &s.CreatedAt, &s.UpdatedAt)
+}
+
+// DeleteSavedSearch removes the user's saved search with its matches.
+// Returns pgx.ErrNoRows if the search is not the user's.
+func (r *AdRepository) DeleteSavedSearch(ctx context.Context, id, userID string) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`, id, userID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// GetSavedSearch returns a saved search by id regardless of its owner.
+func (r *AdRepository) GetSavedSearch(ctx context.Context, id string) (*model.SavedSearch, error) {
+	s, err := scanSavedSearch(r.db.QueryRow(ctx, `SELECT `+savedSearchColumns+` FROM saved_searches WHERE id=$1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ListSavedSearches returns the user's saved searches, oldest first.
+func (r *AdRepository) ListSavedSearches(ctx context.Context, userID string) ([]model.SavedSearch, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+savedSearchColumns+` FROM saved_searches WHERE user_id=$1 ORDER BY created_at, id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.SavedSearch
+	for rows.Next() {
+		s, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, rows.Err()
+}
+
+// EnqueueSavedSearchMatch queues a new ad for the saved search matcher. Call it
+// on the repository passed to InTx so the ad is queued only if it commits.
+func (r *AdRepository) EnqueueSavedSearchMatch(ctx context.Context, adID string) error {
+	_, err := r.db.Exec(ctx, `INSERT INTO saved_search_queue (ad_id) VALUES ($1) ON CONFLICT DO NOTHING`, adID)
+	return err
+}
+
+// MatchQueuedAds takes up to limit ads from saved_search_queue, records a match
+// for every saved search of another user whose filters the ad satisfies and
+// bumps the searches' new_matches. Ads that are no longer ACTIVE are dropped from
+// the queue without matching. Queue rows locked by another replica are skipped.
+// Filters mean the same as in Search: text uses the same 'russian' full-text
+// query, include_subcategories matches any descendant category.
+// Returns the number of dequeued ads and of recorded matches.
+func (r *AdRepository) MatchQueuedAds(ctx context.Context, limit int) (int, int, error) {
+	var ads, matches int
+	err := r.db.QueryRow(ctx, `WITH batch AS (
+		DELETE FROM saved_search_queue WHERE ad_id IN (
+			SELECT ad_id FROM saved_search_queue ORDER BY enqueued_at LIMIT $1 FOR UPDATE SKIP LOCKED
+		) RETURNING ad_id
+	), matched AS (
+		INSERT INTO saved_search_matches (saved_search_id, ad_id)
+		SELECT s.id, a.id
+		FROM batch b
+		JOIN ads a ON a.id = b.ad_id AND a.status = 'ACTIVE' AND a.deleted_at IS NULL AND a.hidden_at IS NULL
+		CROSS JOIN LATERAL (
+			WITH RECURSIVE up AS (
+				SELECT id, parent_id FROM categories WHERE id = a.category_id
+				UNION ALL
+				SELECT c.id, c.parent_id FROM categories c JOIN up ON c.id = up.parent_id
+			) SELECT array_agg(id) AS ids FROM up
+		) anc
+		JOIN saved_searches s ON s.user_id <> a.author_id
+			AND (s.query = '' OR a.search_vector @@ websearch_to_tsquery('russian', s.query))
+			AND (s.category_id IS NULL OR s.category_id = a.category_id OR (s.include_subcategories AND s.category_id = ANY(anc.ids)))
+			AND (s.price_min IS NULL OR a.price >= s.price_min)
+			AND (s.price_max IS NULL OR a.price <= s.price_max)
+			AND (s.condition IS NULL OR a.condition = s.condition)
+		ON CONFLICT DO NOTHING
+		RETURNING saved_search_id
+	), counted AS (
+		UPDATE saved_searches s SET new_matches = s.new_matches + m.n
+		FROM (SELECT saved_search_id, COUNT(*) AS n FROM matched GROUP BY saved_search_id) m
+		WHERE s.id = m.saved_search_id
+		RETURNING m.n
+	)
+	SELECT (SELECT COUNT(*) FROM batch), COALESCE((SELECT SUM(n) FROM counted), 0)`, limit).Scan(&ads, &matches)
+	return ads, matches, err
+}
+
+// ListSavedSearchMatches returns ads matched by the saved search after since,
+// most recently matched first, and their total count. Ads that were deleted, hidden
+// or are no longer ACTIVE are skipped. newest is the latest matched_at of all matches
+// after since, listed or not (zero if there are none); pass it to MarkSavedSearchSeen.
+func (r *AdRepository) ListSavedSearchMatches(ctx context.Context, searchID string, since time.Time, limit, offset int) ([]model.Ad, int, time.Time, error) {
+	var total int
+	var newest *time.Time
+	if err := r.db.QueryRow(ctx, `SELECT
+		COUNT(*) FILTER (WHERE ads.status = 'ACTIVE' AND ads.deleted_at IS NULL AND ads.hidden_at IS NULL),
+		MAX(m.matched_at)
+	FROM saved_search_matches m JOIN ads ON ads.id = m.ad_id
+	WHERE m.saved_search_id=$1 AND m.matched_at > $2`, searchID, since).Scan(&total, &newest); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	var upTo time.Time
+	if newest != nil {
+		upTo = *newest
+	}
+	// the page is bounded by newest so that it agrees with the count above
+	rows, err := r.db.Query(ctx, `SELECT `+adColumns+`
+	FROM ads JOIN (SELECT ad_id, matched_at FROM saved_search_matches WHERE saved_search_id=$1 AND matched_at > $2 AND matched_at <= $3) m ON m.ad_id = ads.id
+	WHERE ads.status = 'ACTIVE' AND ads.deleted_at IS NULL AND ads.hidden_at IS NULL
+	ORDER BY m.matched_at DESC, ads.id DESC
+	LIMIT $4 OFFSET $5`, searchID, since, upTo, limit, offset)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	defer rows.Close()
+	var list []model.Ad
+	for rows.Next() {
+		ad, err := scanAd(rows)
+		if err != nil {
+			return nil, 0, time.Time{}, err
+		}
+		list = append(list, ad)
+	}
+	return list, total, upTo, rows.Err()
+}
+
+// MarkSavedSearchSeen moves last_seen_at of the saved search from since to upTo and
+// takes the matches in between off the new-match counter. Matches found after upTo stay
+// new. Does nothing if last_seen_at is no longer since (marked concurrently).
+func (r *AdRepository) MarkSavedSearchSeen(ctx context.Context, searchID string, since, upTo time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE saved_searches s SET last_seen_at = $3,
+	new_matches = GREATEST(s.new_matches - (
+		SELECT COUNT(*) FROM saved_search_matches WHERE saved_search_id = $1 AND matched_at > $2 AND matched_at <= $3
+	), 0)
+	WHERE s.id = $1 AND s.last_seen_at = $2`, searchID, since, upTo)
+	return err
+}
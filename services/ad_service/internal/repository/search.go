@@ -0,0 +1,456 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"78-pflops/services/ad_service/internal/geo"
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// SearchParams describes ListAds filters at the persistence level.
+type SearchParams struct {
+	Text       string
+	CategoryID *string
+	// IncludeSubcategories extends the category filter to all descendant categories.
+	IncludeSubcategories bool
+	PriceMin             *int64
+	PriceMax             *int64
+	Condition            *string
+	MinSellerRating      *float64
+	City                 *string
+	Region               *string
+	// Near keeps ads with coordinates within the radius and fills Ad.DistanceKm.
+	Near *model.GeoFilter
+	// Attributes are ANDed; keys and value types are checked by the service.
+	Attributes []model.AttributeFilter
+	Limit      int
+	Offset     int
+	// Cursor is an opaque keyset token returned as SearchResult.NextCursor; when set, Offset is ignored.
+	Cursor string
+	// Sort is one of the Sort* names; empty means relevance for text queries and newest otherwise.
+	Sort string
+	// Statuses restricts results to the given ad statuses; empty means any.
+	Statuses []string
+	AuthorID *string
+	// PinPromoted puts promoted ads before the rest, each group in the requested order.
+	PinPromoted bool
+	// IncludeHidden also returns ads hidden by moderation (owner and admin views).
+	IncludeHidden bool
+}
+
+// Sort orders supported by Search.
+const (
+	SortNewest    = "newest"
+	SortOldest    = "oldest"
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortRating    = "rating"
+	SortRelevance = "relevance"
+	SortDistance  = "distance"
+)
+
+// SearchResult is one page of ads plus paging metadata.
+type SearchResult struct {
+	Ads   []model.Ad
+	Total int
+	// TotalEstimated is set when the result set is larger than exactCountLimit and Total
+	// comes from the planner estimate.
+	TotalEstimated bool
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+var (
+	// ErrInvalidCursor is returned for malformed page tokens or tokens issued for another sort order.
+	ErrInvalidCursor = errors.New("invalid page token")
+	// ErrUnknownSort is returned for a Sort value that is not one of the Sort* names.
+	ErrUnknownSort = errors.New("unknown sort order")
+)
+
+// exactCountLimit bounds the COUNT(*) query; larger result sets get an approximate total.
+const exactCountLimit = 10000
+
+// Highlighted fragments are delimited by private-use characters inside ts_headline so that
+// the ad text can be HTML-escaped afterwards without touching the markup.
+const (
+	hlStart = "\uE000"
+	hlStop  = "\uE001"
+)
+
+var (
+	titleHeadlineOpts       = `StartSel="` + hlStart + `", StopSel="` + hlStop + `", HighlightAll=true`
+	descriptionHeadlineOpts = `StartSel="` + hlStart + `", StopSel="` + hlStop + `", MaxFragments=2, MaxWords=20, MinWords=8, FragmentDelimiter=" … "`
+	highlightReplacer       = strings.NewReplacer(hlStart, "<mark>", hlStop, "</mark>")
+)
+
+// safeHighlight escapes ts_headline output and turns fragment delimiters into <mark> tags.
+func safeHighlight(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
+}
+
+// orderKey is one column of a keyset: an SQL expression and the type its text form is cast back to.
+type orderKey struct {
+	expr string
+	typ  string
+}
+
+// searchOrder is a keyset ordering. All keys share one direction so the cursor condition
+// is a single row comparison; the last key is always the unique id.
+type searchOrder struct {
+	name string
+	keys []orderKey
+	desc bool
+}
+
+var (
+	// bumped_at starts at created_at and moves forward when the owner bumps the ad
+	orderNewest = searchOrder{name: SortNewest, desc: true, keys: []orderKey{
+		{"bumped_at", "timestamptz"}, {"id", "uuid"},
+	}}
+	orderOldest = searchOrder{name: SortOldest, keys: []orderKey{
+		{"created_at", "timestamptz"}, {"id", "uuid"},
+	}}
+	orderPriceAsc = searchOrder{name: SortPriceAsc, keys: []orderKey{
+		{"price", "bigint"}, {"id", "uuid"},
+	}}
+	orderPriceDesc = searchOrder{name: SortPriceDesc, desc: true, keys: []orderKey{
+		{"price", "bigint"}, {"id", "uuid"},
+	}}
+	// ads of sellers without reviews go last
+	orderRating = searchOrder{name: SortRating, desc: true, keys: []orderKey{
+		{"COALESCE(seller_rating_cached, 0)", "double precision"}, {"seller_review_count", "int"}, {"created_at", "timestamptz"}, {"id", "uuid"},
+	}}
+	orderRelevance = searchOrder{name: SortRelevance, desc: true, keys: []orderKey{
+		{"ts_rank_cd(search_vector, q)", "real"}, {"created_at", "timestamptz"}, {"id", "uuid"},
+	}}
+	// nearest first; near_lat and near_lon come from the Near point joined in Search
+	orderDistance = searchOrder{name: SortDistance, keys: []orderKey{
+		{distanceExpr, "double precision"}, {"id", "uuid"},
+	}}
+
+	searchOrders = map[string]searchOrder{
+		SortNewest:    orderNewest,
+		SortOldest:    orderOldest,
+		SortPriceAsc:  orderPriceAsc,
+		SortPriceDesc: orderPriceDesc,
+		SortRating:    orderRating,
+		SortRelevance: orderRelevance,
+		SortDistance:  orderDistance,
+	}
+)
+
+// distanceExpr is the distance in km from an ad to the Near point.
+const distanceExpr = "ad_distance_km(lat, lon, near_lat, near_lon)"
+
+// ValidSort reports whether name is a supported sort order (empty means default).
+func ValidSort(name string) bool {
+	if name == "" {
+		return true
+	}
+	_, ok := searchOrders[name]
+	return ok
+}
+
+// pickOrder resolves the requested sort; relevance needs a text query and distance needs
+// a Near point, without them both fall back to newest.
+func pickOrder(sort string, hasText, hasNear bool) (searchOrder, error) {
+	switch {
+	case sort == "" && hasText:
+		return orderRelevance, nil
+	case sort == "", sort == SortRelevance && !hasText, sort == SortDistance && !hasNear:
+		return orderNewest, nil
+	}
+	o, ok := searchOrders[sort]
+	if !ok {
+		return searchOrder{}, ErrUnknownSort
+	}
+	return o, nil
+}
+
+// pinned returns the order with promoted ads first. The flag becomes the leading key so that
+// the keyset cursor keeps working across the boundary between the two groups.
+func (o searchOrder) pinned() searchOrder {
+	lead := orderKey{"promoted", "boolean"}
+	if !o.desc {
+		lead.expr = "NOT promoted"
+	}
+	o.keys = append([]orderKey{lead}, o.keys...)
+	return o
+}
+
+func (o searchOrder) orderBy() string {
+	dir := " ASC"
+	if o.desc {
+		dir = " DESC"
+	}
+	parts := make([]string, len(o.keys))
+	for i, k := range o.keys {
+		parts[i] = k.expr + dir
+	}
+	return strings.Join(parts, ", ")
+}
+
+// keyColumns selects the keys as text so the last row of a page can be turned into a cursor.
+func (o searchOrder) keyColumns() string {
+	parts := make([]string, len(o.keys))
+	for i, k := range o.keys {
+		parts[i] = "(" + k.expr + ")::text"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// cursorCond returns the "after cursor" row comparison starting at placeholder idx.
+func (o searchOrder) cursorCond(idx int) string {
+	cols := make([]string, len(o.keys))
+	vals := make([]string, len(o.keys))
+	for i, k := range o.keys {
+		cols[i] = k.expr
+		vals[i] = fmt.Sprintf("$%d::%s", idx+i, k.typ)
+	}
+	op := ">"
+	if o.desc {
+		op = "<"
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ", "), op, strings.Join(vals, ", "))
+}
+
+type cursorPayload struct {
+	Order string   `json:"o"`
+	Keys  []string `json:"k"`
+}
+
+func encodeCursor(o searchOrder, keys []string) string {
+	b, _ := json.Marshal(cursorPayload{Order: o.name, Keys: keys})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(o searchOrder, token string) ([]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursorPayload
+	if err := json.Unmarshal(b, &c); err != nil || c.Order != o.name || len(c.Keys) != len(o.keys) {
+		return nil, ErrInvalidCursor
+	}
+	return c.Keys, nil
+}
+
+// Search filters ads. When Text is set it uses the search_vector full-text index
+// (the 'russian' configuration stems Cyrillic words with the Russian stemmer and Latin
+// words with the English one), orders by relevance unless Sort says otherwise and fills
+// highlighted snippets.
+func (r *AdRepository) Search(ctx context.Context, p SearchParams) (*SearchResult, error) {
+	order, err := pickOrder(p.Sort, p.Text != "", p.Near != nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.PinPromoted {
+		order = order.pinned()
+	}
+	from := `ads`
+	where := `deleted_at IS NULL`
+	if !p.IncludeHidden {
+		where += ` AND hidden_at IS NULL`
+	}
+	args := []any{}
+	idx := 1
+	appendCond := func(cond string, val any) {
+		where += fmt.Sprintf(" AND %s $%d", cond, idx)
+		args = append(args, val)
+		idx++
+	}
+	if p.Text != "" {
+		from += fmt.Sprintf(`, websearch_to_tsquery('russian', $%d) AS q`, idx)
+		args = append(args, p.Text)
+		idx++
+		where += ` AND search_vector @@ q`
+	}
+	if p.CategoryID != nil {
+		if p.IncludeSubcategories {
+			where += fmt.Sprintf(` AND category_id IN (WITH RECURSIVE tree AS (
+				SELECT id FROM categories WHERE id = $%d
+				UNION ALL
+				SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+			) SELECT id FROM tree)`, idx)
+			args = append(args, *p.CategoryID)
+			idx++
+		} else {
+			appendCond("category_id =", *p.CategoryID)
+		}
+	}
+	if p.PriceMin != nil {
+		appendCond("price >=", *p.PriceMin)
+	}
+	if p.PriceMax != nil {
+		appendCond("price <=", *p.PriceMax)
+	}
+	if p.Condition != nil {
+		appendCond("condition =", *p.Condition)
+	}
+	if p.MinSellerRating != nil {
+		// same expression as in the rating sort (idx_ads_rating_sort)
+		appendCond("COALESCE(seller_rating_cached, 0) >=", *p.MinSellerRating)
+	}
+	switch len(p.Statuses) {
+	case 0:
+	case 1:
+		// plain equality lets the planner use idx_ads_status_newest for the sort as well
+		appendCond("status =", p.Statuses[0])
+	default:
+		where += fmt.Sprintf(" AND status = ANY($%d)", idx)
+		args = append(args, p.Statuses)
+		idx++
+	}
+	if p.AuthorID != nil {
+		appendCond("author_id =", *p.AuthorID)
+	}
+	if p.City != nil {
+		appendCond("city =", *p.City)
+	}
+	if p.Region != nil {
+		appendCond("region =", *p.Region)
+	}
+	if p.Near != nil {
+		from += fmt.Sprintf(`, (SELECT $%d::double precision AS near_lat, $%d::double precision AS near_lon) AS near`, idx, idx+1)
+		args = append(args, p.Near.Lat, p.Near.Lon)
+		idx += 2
+		// the bounding box lets idx_ads_coordinates cut the candidates before the exact distance check
+		if minLat, maxLat, minLon, maxLon, ok := geo.BoundingBox(p.Near.Lat, p.Near.Lon, p.Near.RadiusKm); ok {
+			appendCond("lat >=", minLat)
+			appendCond("lat <=", maxLat)
+			appendCond("lon >=", minLon)
+			appendCond("lon <=", maxLon)
+		}
+		appendCond(distanceExpr+" <=", p.Near.RadiusKm)
+	}
+	for _, f := range p.Attributes {
+		if f.Equals != nil {
+			b, err := json.Marshal(map[string]string{f.Key: *f.Equals})
+			if err != nil {
+				return nil, err
+			}
+			where += fmt.Sprintf(" AND attributes @> $%d::jsonb", idx)
+			args = append(args, string(b))
+			idx++
+		}
+		if f.Min == nil && f.Max == nil {
+			continue
+		}
+		// values that are not numbers (e.g. left from an older schema) never match a range
+		num := fmt.Sprintf(`(CASE WHEN attributes->>$%d ~ '^-?[0-9]+(\.[0-9]+)?$' THEN (attributes->>$%d)::numeric END)`, idx, idx)
+		args = append(args, f.Key)
+		idx++
+		if f.Min != nil {
+			appendCond(num+" >=", *f.Min)
+		}
+		if f.Max != nil {
+			appendCond(num+" <=", *f.Max)
+		}
+	}
+
+	total, estimated, err := r.countAds(ctx, from, where, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page query: filters + optional keyset condition, one extra row to detect the next page.
+	pageWhere := where
+	pageArgs := append([]any{}, args...)
+	offset := p.Offset
+	if p.Cursor != "" {
+		keys, err := decodeCursor(order, p.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		pageWhere += " AND " + order.cursorCond(idx)
+		for _, k := range keys {
+			pageArgs = append(pageArgs, k)
+		}
+		idx += len(keys)
+		offset = 0
+	}
+	selectCols := adColumns + `, '', ''`
+	if p.Text != "" {
+		selectCols = adColumns + fmt.Sprintf(`, ts_headline('russian', title, q, $%d), ts_headline('russian', description, q, $%d)`, idx, idx+1)
+		pageArgs = append(pageArgs, titleHeadlineOpts, descriptionHeadlineOpts)
+		idx += 2
+	}
+	if p.Near != nil {
+		selectCols += ", " + distanceExpr
+	} else {
+		selectCols += ", NULL::double precision"
+	}
+	query := fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d",
+		selectCols, order.keyColumns(), from, pageWhere, order.orderBy(), idx, idx+1)
+	pageArgs = append(pageArgs, p.Limit+1, offset)
+
+	rows, err := r.db.Query(ctx, query, pageArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	res := &SearchResult{Total: total, TotalEstimated: estimated}
+	var lastKeys []string
+	for rows.Next() {
+		var titleHL, descHL string
+		var distance *float64
+		keys := make([]string, len(order.keys))
+		extra := []any{&titleHL, &descHL, &distance}
+		for i := range keys {
+			extra = append(extra, &keys[i])
+		}
+		ad, err := scanAd(rows, extra...)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Ads) == p.Limit {
+			// extra row: there is a next page after the last returned ad
+			res.NextCursor = encodeCursor(order, lastKeys)
+			break
+		}
+		if p.Text != "" {
+			ad.TitleHighlight = safeHighlight(titleHL)
+			ad.DescriptionHighlight = safeHighlight(descHL)
+		}
+		ad.DistanceKm = distance
+		res.Ads = append(res.Ads, ad)
+		lastKeys = keys
+	}
+	return res, rows.Err()
+}
+
+// countAds counts ads matching the filters exactly up to exactCountLimit and falls back
+// to the planner's row estimate for larger result sets.
+func (r *AdRepository) countAds(ctx context.Context, from, where string, args []any) (int, bool, error) {
+	var n int
+	err := r.db.QueryRow(ctx, fmt.Sprintf("SELECT COUNT(*) FROM (SELECT 1 FROM %s WHERE %s LIMIT %d) t", from, where, exactCountLimit+1), args...).Scan(&n)
+	if err != nil {
+		return 0, false, err
+	}
+	if n <= exactCountLimit {
+		return n, false, nil
+	}
+	var plan []byte
+	if err := r.db.QueryRow(ctx, fmt.Sprintf("EXPLAIN (FORMAT JSON) SELECT 1 FROM %s WHERE %s", from, where), args...).Scan(&plan); err != nil {
+		return 0, false, err
+	}
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil || len(explain) == 0 {
+		return n, true, nil
+	}
+	if est := int(explain[0].Plan.Rows); est > n {
+		return est, true, nil
+	}
+	return n, true, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	keys := []string{"2025-11-21 12:00:00.123456+00", "8d0b7c5e-4a4e-4d8e-9b7e-2f6f0b1c2d3e"}
+	token := encodeCursor(orderNewest, keys)
+	got, err := decodeCursor(orderNewest, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0] != keys[0] || got[1] != keys[1] {
+		t.Errorf("unexpected keys %v", got)
+	}
+}
+
+func TestCursorRejectsOtherOrderAndGarbage(t *testing.T) {
+	token := encodeCursor(orderNewest, []string{"a", "b"})
+	if _, err := decodeCursor(orderRelevance, token); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor for other order, got %v", err)
+	}
+	if _, err := decodeCursor(orderNewest, "%%%"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor for garbage, got %v", err)
+	}
+}
+
+func TestCursorCond(t *testing.T) {
+	got := orderNewest.cursorCond(3)
+	want := "(bumped_at, id) < ($3::timestamptz, $4::uuid)"
+	if got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func TestSafeHighlight(t *testing.T) {
+	got := safeHighlight("<b>" + hlStart + "iPhone" + hlStop + "</b>")
+	want := "&lt;b&gt;<mark>iPhone</mark>&lt;/b&gt;"
+	if got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func TestPickOrder(t *testing.T) {
+	cases := []struct {
+		sort    string
+		hasText bool
+		hasNear bool
+		want    string
+	}{
+		{"", false, false, SortNewest},
+		{"", true, false, SortRelevance},
+		{SortRelevance, false, false, SortNewest},
+		{SortPriceAsc, true, false, SortPriceAsc},
+		{SortRating, false, false, SortRating},
+		{"", false, true, SortNewest},
+		{SortDistance, false, false, SortNewest},
+		{SortDistance, true, true, SortDistance},
+	}
+	for _, c := range cases {
+		o, err := pickOrder(c.sort, c.hasText, c.hasNear)
+		if err != nil || o.name != c.want {
+			t.Errorf("pickOrder(%q, %v, %v) = %q, %v; want %q", c.sort, c.hasText, c.hasNear, o.name, err, c.want)
+		}
+	}
+	if _, err := pickOrder("cheapest", false, false); !errors.Is(err, ErrUnknownSort) {
+		t.Errorf("expected ErrUnknownSort, got %v", err)
+	}
+}
+
+func TestOrderByAscending(t *testing.T) {
+	if got := orderPriceAsc.orderBy(); got != "price ASC, id ASC" {
+		t.Errorf("unexpected ORDER BY %q", got)
+	}
+	if got := orderPriceAsc.cursorCond(1); got != "(price, id) > ($1::bigint, $2::uuid)" {
+		t.Errorf("unexpected cursor condition %q", got)
+	}
+}
+
+func TestPinnedOrder(t *testing.T) {
+	if got := orderNewest.pinned().orderBy(); got != "promoted DESC, bumped_at DESC, id DESC" {
+		t.Errorf("unexpected ORDER BY %q", got)
+	}
+	if got := orderPriceAsc.pinned().cursorCond(1); got != "(NOT promoted, price, id) > ($1::boolean, $2::bigint, $3::uuid)" {
+		t.Errorf("unexpected cursor condition %q", got)
+	}
+	// a token of the unpinned listing does not fit the pinned one
+	token := encodeCursor(orderNewest, []string{"a", "b"})
+	if _, err := decodeCursor(orderNewest.pinned(), token); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
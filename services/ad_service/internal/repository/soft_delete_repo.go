@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// GetDeleted returns a soft-deleted ad with DeletedAt set, or pgx.ErrNoRows if
+// the ad does not exist or is not deleted.
+func (r *AdRepository) GetDeleted(ctx context.Context, id string) (*model.Ad, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+adColumns+`, deleted_at FROM ads WHERE id=$1 AND deleted_at IS NOT NULL`, id)
+	var deletedAt time.Time
+	ad, err := scanAd(row, &deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	ad.DeletedAt = &deletedAt
+	return &ad, nil
+}
+
+// Restore clears deleted_at of a soft-deleted ad. Returns pgx.ErrNoRows if the
+// ad is not deleted (e.g. already restored or purged concurrently).
+func (r *AdRepository) Restore(ctx context.Context, id string) error {
+	res, err := r.db.Exec(ctx, `UPDATE ads SET deleted_at = NULL, updated_at = NOW() WHERE id=$1 AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// PurgeDeleted hard-deletes up to limit ads soft-deleted before the given time,
+// together with their images, favorites and reviews (ON DELETE CASCADE), and
+// returns how many were removed. The cached rating of every affected seller is
+// recomputed in the same transaction, since the cascade drops their reviews.
+// Rows locked by a concurrent purge are skipped.
+func (r *AdRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `DELETE FROM ads WHERE id IN (
+		SELECT id FROM ads WHERE deleted_at < $1
+		ORDER BY deleted_at LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	) RETURNING author_id`, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	var authorIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		authorIDs = append(authorIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	// sorted so that concurrent purges take the seller locks in the same order
+	sellers := slices.Compact(slices.Sorted(slices.Values(authorIDs)))
+	for _, sellerID := range sellers {
+		if err := refreshSellerRating(ctx, tx, sellerID); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return len(authorIDs), nil
+}
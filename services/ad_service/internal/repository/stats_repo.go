@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// CountViews records views that are not repeats of the same viewer's view of the
+// same ad within window and adds them to ad_stats_daily. Each (ad, viewer) pair
+// may appear in views at most once. Views of the ad's author and of ads that no
+// longer exist are skipped. Returns the number of counted views.
+func (r *AdRepository) CountViews(ctx context.Context, views []model.AdView, window time.Duration) (int, error) {
+	if len(views) == 0 {
+		return 0, nil
+	}
+	adIDs := make([]string, len(views))
+	keys := make([]string, len(views))
+	times := make([]time.Time, len(views))
+	for i, v := range views {
+		adIDs[i], keys[i], times[i] = v.AdID, v.ViewerKey, v.At
+	}
+	var counted int
+	err := r.db.QueryRow(ctx, `WITH v AS (
+		SELECT v.ad_id, v.viewer_key, v.seen_at
+		FROM unnest($1::uuid[], $2::text[], $3::timestamptz[]) AS v(ad_id, viewer_key, seen_at)
+		JOIN ads ON ads.id = v.ad_id AND v.viewer_key <> ads.author_id::text
+	), counted AS (
+		INSERT INTO ad_view_dedup (ad_id, viewer_key, seen_at) SELECT ad_id, viewer_key, seen_at FROM v
+		ON CONFLICT (ad_id, viewer_key) DO UPDATE SET seen_at = EXCLUDED.seen_at
+		WHERE ad_view_dedup.seen_at <= EXCLUDED.seen_at - make_interval(secs => $4)
+		RETURNING ad_id, seen_at
+	), daily AS (
+		INSERT INTO ad_stats_daily (ad_id, day, views)
+		SELECT ad_id, (seen_at AT TIME ZONE 'UTC')::date, COUNT(*) FROM counted GROUP BY 1, 2
+		ON CONFLICT (ad_id, day) DO UPDATE SET views = ad_stats_daily.views + EXCLUDED.views
+		RETURNING views
+	)
+	SELECT COUNT(*) FROM counted`, adIDs, keys, times, window.Seconds()).Scan(&counted)
+	return counted, err
+}
+
+// AddDailyStats adds favorites and contact reveal deltas to ad_stats_daily.
+// Deltas of unknown kinds and of ads that no longer exist are ignored.
+func (r *AdRepository) AddDailyStats(ctx context.Context, deltas []model.AdStatDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+	adIDs := make([]string, len(deltas))
+	days := make([]time.Time, len(deltas))
+	kinds := make([]string, len(deltas))
+	ns := make([]int32, len(deltas))
+	for i, d := range deltas {
+		adIDs[i], days[i], kinds[i], ns[i] = d.AdID, d.Day, d.Kind, int32(d.N)
+	}
+	_, err := r.db.Exec(ctx, `INSERT INTO ad_stats_daily (ad_id, day, favorites, contact_reveals)
+	SELECT d.ad_id, d.day,
+		COALESCE(SUM(d.n) FILTER (WHERE d.kind = $5), 0),
+		COALESCE(SUM(d.n) FILTER (WHERE d.kind = $6), 0)
+	FROM unnest($1::uuid[], $2::date[], $3::text[], $4::int[]) AS d(ad_id, day, kind, n)
+	JOIN ads ON ads.id = d.ad_id
+	WHERE d.kind IN ($5, $6)
+	GROUP BY d.ad_id, d.day
+	ON CONFLICT (ad_id, day) DO UPDATE SET
+		favorites = ad_stats_daily.favorites + EXCLUDED.favorites,
+		contact_reveals = ad_stats_daily.contact_reveals + EXCLUDED.contact_reveals`,
+		adIDs, days, kinds, ns, model.StatFavorites, model.StatContactReveals)
+	return err
+}
+
+// PurgeViewDedup forgets views older than before; they can no longer suppress a repeat.
+func (r *AdRepository) PurgeViewDedup(ctx context.Context, before time.Time) (int, error) {
+	res, err := r.db.Exec(ctx, `DELETE FROM ad_view_dedup WHERE seen_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
+
+// ListDailyStats returns the ad's counters for days since the given UTC day, oldest first.
+// Days without activity are absent.
+func (r *AdRepository) ListDailyStats(ctx context.Context, adID string, since time.Time) ([]model.AdDailyStats, error) {
+	rows, err := r.db.Query(ctx, `SELECT day, views, favorites, contact_reveals FROM ad_stats_daily
+	WHERE ad_id=$1 AND day >= $2::date ORDER BY day`, adID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.AdDailyStats
+	for rows.Next() {
+		var d model.AdDailyStats
+		if err := rows.Scan(&d.Day, &d.Views, &d.Favorites, &d.ContactReveals); err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
+
+// SellerStats sums the counters of the author's ads since the given UTC day,
+// most viewed first, and returns the number of the author's ads.
+func (r *AdRepository) SellerStats(ctx context.Context, authorID string, since time.Time, limit, offset int) ([]model.AdStatsSummary, int, error) {
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM ads WHERE author_id=$1 AND deleted_at IS NULL`, authorID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.Query(ctx, `SELECT ads.id, ads.title, ads.status,
+		COALESCE(SUM(d.views), 0), COALESCE(SUM(d.favorites), 0), COALESCE(SUM(d.contact_reveals), 0)
+	FROM ads LEFT JOIN ad_stats_daily d ON d.ad_id = ads.id AND d.day >= $2::date
+	WHERE ads.author_id=$1 AND ads.deleted_at IS NULL
+	GROUP BY ads.id
+	ORDER BY 4 DESC, ads.created_at DESC, ads.id
+	LIMIT $3 OFFSET $4`, authorID, since, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var list []model.AdStatsSummary
+	for rows.Next() {
+		var s model.AdStatsSummary
+		if err := rows.Scan(&s.AdID, &s.Title, &s.Status, &s.Views, &s.Favorites, &s.ContactReveals); err != nil {
+			return nil, 0, err
+		}
+		list = append(list, s)
+	}
+	return list, total, rows.Err()
+}
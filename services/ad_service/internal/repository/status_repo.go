@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrStatusConflict is returned by ChangeStatus when the ad is no longer in the expected status.
+var ErrStatusConflict = errors.New("ad status changed concurrently")
+
+// ChangeStatus moves an ad from status from to status to and records the transition in
+// ad_status_history. An empty actorID records a system change (e.g. expiry).
+func (r *AdRepository) ChangeStatus(ctx context.Context, adID, from, to, actorID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, `UPDATE ads SET status = $3, updated_at = NOW() WHERE id = $1 AND status = $2 AND deleted_at IS NULL`, adID, from, to)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return ErrStatusConflict
+	}
+	if err := insertStatusHistory(ctx, tx, adID, from, to, actorID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func insertStatusHistory(ctx context.Context, tx pgx.Tx, adID, from, to, actorID string) error {
+	_, err := tx.Exec(ctx, `INSERT INTO ad_status_history (id, ad_id, from_status, to_status, changed_by)
+	VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid)`, uuid.New().String(), adID, from, to, actorID)
+	return err
+}
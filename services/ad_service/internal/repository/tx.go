@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// dbtx is satisfied by both *pgxpool.Pool and pgx.Tx, so every repository
+// method runs either on its own or as part of a unit of work.
+type dbtx interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// InTx runs fn as a unit of work: the repository passed to fn executes every
+// call in one transaction, committed when fn returns nil and rolled back
+// otherwise. Nested calls become savepoints of the outer transaction.
+func (r *AdRepository) InTx(ctx context.Context, fn func(tx *AdRepository) error) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return fn(&AdRepository{db: tx})
+	})
+}
@@ -3,6 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	"78-pflops/services/ad_service/internal/model"
 	"78-pflops/services/ad_service/internal/repository"
@@ -12,139 +17,605 @@ import (
 type repoInterface interface {
 	Create(ctx context.Context, ad *model.Ad) error
 	Get(ctx context.Context, id string) (*model.Ad, error)
-	Search(ctx context.Context, text string, categoryID *string, priceMin, priceMax *int64, condition *string, limit, offset int) ([]model.Ad, int, error)
-	Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition, status *string) error
+	Search(ctx context.Context, p repository.SearchParams) (*repository.SearchResult, error)
+	Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition *string, loc *model.Location, attrs map[string]string) error
+	ChangeStatus(ctx context.Context, adID, from, to, actorID string) error
+	RenewAd(ctx context.Context, adID, from string, expiresAt time.Time, actorID string) error
+	ArchiveExpired(ctx context.Context, now time.Time, limit int) (int, error)
 	Delete(ctx context.Context, id string, authorID string) error
 	AttachMedia(ctx context.Context, adID, mediaID string) error
 	ListImages(ctx context.Context, adID string) ([]model.AdImage, error)
+	ListImagesByAds(ctx context.Context, adIDs []string) (map[string][]model.AdImage, error)
 	DetachMedia(ctx context.Context, adID, mediaID string) error
 	ReplaceImages(ctx context.Context, adID string, mediaIDs []string) error
+	ReorderImages(ctx context.Context, adID string, imageIDs []string) error
+	SetPrimaryImage(ctx context.Context, adID, imageID string) error
+	AddFavorite(ctx context.Context, userID, adID string) (bool, error)
+	RemoveFavorite(ctx context.Context, userID, adID string) error
+	ListFavorites(ctx context.Context, userID string, limit, offset int) ([]model.Ad, int, error)
+	FavoriteAdIDs(ctx context.Context, userID string, adIDs []string) (map[string]bool, error)
+	CreateReview(ctx context.Context, rv *model.Review, sellerID string) error
+	SellerRating(ctx context.Context, sellerID string) (*float64, int, error)
+	DeleteReview(ctx context.Context, reviewID, reviewerID string) error
+	ListReviewsByAd(ctx context.Context, adID string, limit, offset int) ([]model.Review, int, error)
+	ListReviewsBySeller(ctx context.Context, sellerID string, limit, offset int) ([]model.Review, int, error)
+	ListCategories(ctx context.Context, includeArchived bool) ([]model.Category, error)
+	GetCategory(ctx context.Context, idOrSlug string) (*model.Category, error)
+	CreateCategory(ctx context.Context, c *model.Category) error
+	UpdateCategory(ctx context.Context, c *model.Category) error
+	ArchiveCategory(ctx context.Context, id string) error
+	ListCategoryAttributes(ctx context.Context, categoryIDs []string) ([]model.AttributeDef, error)
+	ReplaceCategoryAttributes(ctx context.Context, categoryID string, defs []model.AttributeDef) error
+	GetDeleted(ctx context.Context, id string) (*model.Ad, error)
+	Restore(ctx context.Context, id string) error
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error)
+	GetForUpdate(ctx context.Context, id string) (*model.Ad, error)
+	InsertRevision(ctx context.Context, rev *model.AdRevision) error
+	ListRevisions(ctx context.Context, adID string, limit, offset int) ([]model.AdRevision, int, error)
+	GetRevision(ctx context.Context, adID string, revision int) (*model.AdRevision, error)
+	InsertPricePoint(ctx context.Context, adID string, price int64, actorID string) error
+	ListPriceHistory(ctx context.Context, adID string) ([]model.PricePoint, error)
+	NotifyPriceDrop(ctx context.Context, adID string, oldPrice, newPrice int64) (int, error)
+	LockSavedSearches(ctx context.Context, userID string) (int, error)
+	CreateSavedSearch(ctx context.Context, s *model.SavedSearch) error
+	UpdateSavedSearch(ctx context.Context, s *model.SavedSearch) error
+	DeleteSavedSearch(ctx context.Context, id, userID string) error
+	GetSavedSearch(ctx context.Context, id string) (*model.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID string) ([]model.SavedSearch, error)
+	EnqueueSavedSearchMatch(ctx context.Context, adID string) error
+	MatchQueuedAds(ctx context.Context, limit int) (int, int, error)
+	ListSavedSearchMatches(ctx context.Context, searchID string, since time.Time, limit, offset int) ([]model.Ad, int, time.Time, error)
+	MarkSavedSearchSeen(ctx context.Context, searchID string, since, upTo time.Time) error
+	CountViews(ctx context.Context, views []model.AdView, window time.Duration) (int, error)
+	AddDailyStats(ctx context.Context, deltas []model.AdStatDelta) error
+	PurgeViewDedup(ctx context.Context, before time.Time) (int, error)
+	ListDailyStats(ctx context.Context, adID string, since time.Time) ([]model.AdDailyStats, error)
+	SellerStats(ctx context.Context, authorID string, since time.Time, limit, offset int) ([]model.AdStatsSummary, int, error)
+	InsertEvent(ctx context.Context, ev *model.AdEvent) error
+	SettledEventSeq(ctx context.Context) (int64, error)
+	LockPendingEvents(ctx context.Context, upTo int64, limit int) ([]model.AdEvent, error)
+	MarkEventsPublished(ctx context.Context, ids []string) error
+	AddLedgerEntry(ctx context.Context, e *model.LedgerEntry) error
+	GetBalance(ctx context.Context, userID string) (int64, error)
+	ListLedger(ctx context.Context, userID string, limit, offset int) ([]model.LedgerEntry, int, error)
+	SetPromotion(ctx context.Context, adID string, until time.Time) error
+	BumpAd(ctx context.Context, adID string, at time.Time) error
+	ExpirePromotions(ctx context.Context, now time.Time, limit int) (int, error)
+	CreateReport(ctx context.Context, rp *model.Report) error
+	CountPendingReporters(ctx context.Context, adID string) (int, error)
+	SetAdHidden(ctx context.Context, adID string, hidden bool) error
+	UpdateAdReports(ctx context.Context, adID string, from []string, to, resolution, reviewerID string) (int, error)
+	GetReportForUpdate(ctx context.Context, id string) (*model.Report, error)
+	ResolveReport(ctx context.Context, id, resolution, reviewerID string) error
+	ListReports(ctx context.Context, status, adID string, limit, offset int) ([]model.Report, int, error)
+	InsertModerationAction(ctx context.Context, a *model.ModerationAction) error
+	ListModerationActions(ctx context.Context, adID string, limit, offset int) ([]model.ModerationAction, int, error)
+	// InTx выполняет fn как единицу работы: все вызовы tx идут в одной транзакции,
+	// которая фиксируется, если fn вернула nil, и откатывается иначе.
+	InTx(ctx context.Context, fn func(tx repoInterface) error) error
+}
+
+// pgRepo адаптирует *repository.AdRepository к repoInterface: транзакционный
+// репозиторий из InTx снова оборачивается, чтобы сервис работал с ним как с обычным.
+type pgRepo struct {
+	*repository.AdRepository
+}
+
+func (r pgRepo) InTx(ctx context.Context, fn func(tx repoInterface) error) error {
+	return r.AdRepository.InTx(ctx, func(tx *repository.AdRepository) error {
+		return fn(pgRepo{tx})
+	})
+}
+
+// Config holds service-level settings read from the environment in cmd/ad-service.
+type Config struct {
+	// AdminIDs — пользователи, которым доступны административные операции (каталог категорий и т.п.).
+	AdminIDs []string
+	// DefaultAdLifetime — срок жизни объявления, если категория его не задаёт (0 — 30 дней).
+	DefaultAdLifetime time.Duration
+	// RestoreWindow — сколько удалённое объявление можно восстановить, после чего оно очищается (0 — 30 дней).
+	RestoreWindow time.Duration
+	// MaxImagesPerAd — предел числа изображений у одного объявления (0 — 10).
+	MaxImagesPerAd int
+	// ViewDedupWindow — повторный просмотр тем же зрителем в пределах окна не учитывается (0 — 30 минут).
+	ViewDedupWindow time.Duration
+	// PromotionDayPrice — цена одного дня продвижения во внутренних кредитах (0 — 100).
+	PromotionDayPrice int64
+	// BumpCooldown — как часто владелец может поднимать объявление (0 — 24 часа).
+	BumpCooldown time.Duration
+	// ReportHideThreshold — после жалоб стольких разных пользователей объявление скрывается до проверки (0 — 3).
+	ReportHideThreshold int
 }
 
 type AdService struct {
-	repo repoInterface
+	repo  repoInterface
+	cfg   Config
+	stats *statsBuffer // nil — статистика не собирается
 }
 
 // NewAdService keeps backward compatibility with concrete repository.
-func NewAdService(repo *repository.AdRepository) *AdService { return &AdService{repo: repo} }
+func NewAdService(repo *repository.AdRepository, cfg Config) *AdService {
+	return &AdService{repo: pgRepo{repo}, cfg: cfg, stats: newStatsBuffer()}
+}
+
+// inTx выполняет fn в транзакции с копией сервиса, чей репозиторий привязан к этой транзакции.
+func (s *AdService) inTx(ctx context.Context, fn func(tx *AdService) error) error {
+	return s.repo.InTx(ctx, func(r repoInterface) error {
+		txs := *s
+		txs.repo = r
+		return fn(&txs)
+	})
+}
+
+var (
+	// ErrPermissionDenied is returned when the caller is not allowed to perform the operation.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrAdNotFound is returned for missing ads and for ads the viewer is not allowed to see.
+	ErrAdNotFound = errors.New("ad not found")
+)
+
+func (s *AdService) isAdmin(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	for _, id := range s.cfg.AdminIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
 
 // Filters for listing ads. Keep minimal yet flexible.
 type Filters struct {
 	Text       string
-	CategoryID *string
-	PriceMin   *int64
-	PriceMax   *int64
-	Condition  *string
-	Limit      int
-	Offset     int
+	CategoryID *string // id или slug категории
+	// IncludeSubcategories — искать также во всех дочерних категориях CategoryID.
+	IncludeSubcategories bool
+	PriceMin             *int64 // границы включительно, nil — без ограничения
+	PriceMax             *int64
+	Condition            *string  // одно из model.Condition*
+	MinSellerRating      *float64 // 0..5; продавцы без отзывов считаются с рейтингом 0
+	City                 *string  // названия города и региона — из справочника internal/geo
+	Region               *string
+	Near                 *model.GeoFilter        // только объявления с координатами в радиусе; у найденных заполняется DistanceKm
+	Attributes           []model.AttributeFilter // только вместе с CategoryID; ключи и типы — по схеме категории
+	Limit                int
+	Offset               int
+	PageToken            string // курсор из AdPage.NextPageToken; если задан, Offset игнорируется
+	ViewerID             string // если задан, у объявлений выставляется IsFavorite
+	Sort                 string // одно из repository.Sort*; пусто — по релевантности для Text, иначе newest; distance — только с Near
+	// Status — пусто означает ACTIVE; другие статусы видны только владельцу (ViewerID).
+	Status string
+}
+
+// CreateAd(user_id, title, description, price, category_id?, location?, attributes?)
+// Пустая категория означает категорию по умолчанию, неизвестная или архивная — ошибку.
+// Характеристики проверяются по схеме категории.
+func (s *AdService) CreateAd(ctx context.Context, userID, title, description string, price int64, categoryID string, loc *model.Location, attrs map[string]string) (*model.Ad, error) {
+	var ad *model.Ad
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		if ad, err = tx.createAd(ctx, userID, title, description, price, categoryID, loc, attrs); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdCreated, ad.ID, userID, snapshotOf(ad))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ad, nil
 }
 
-// CreateAd(user_id, title, description, price)
-func (s *AdService) CreateAd(ctx context.Context, userID, title, description string, price int64) (*model.Ad, error) {
+// createAd сохраняет объявление без события ad.created — его пишет вызывающий.
+func (s *AdService) createAd(ctx context.Context, userID, title, description string, price int64, categoryID string, loc *model.Location, attrs map[string]string) (*model.Ad, error) {
 	// Minimal defaults to satisfy schema
-	defaultCategory := "00000000-0000-0000-0000-000000000000"
-	defaultCondition := "NEW"
+	defaultCondition := model.ConditionNew
+	var location model.Location
+	if loc != nil {
+		location = *loc
+		if err := normalizeLocation(&location); err != nil {
+			return nil, err
+		}
+	}
+	category, err := s.resolveAdCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	lifetime, err := s.adLifetime(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := s.categoryAttributes(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := validateAttributes(schema, attrs)
+	if err != nil {
+		return nil, err
+	}
 	ad := &model.Ad{
 		AuthorID:    userID,
 		Title:       title,
 		Description: description,
 		Price:       price,
-		CategoryID:  defaultCategory,
+		CategoryID:  category.ID,
 		Condition:   defaultCondition,
-		Status:      "ACTIVE",
+		Status:      model.StatusActive,
+		ExpiresAt:   time.Now().Add(lifetime),
+		Location:    location,
+		Attributes:  values,
+	}
+	// рейтинг продавца кэшируется на каждом объявлении, новое получает текущие итоги отзывов
+	if ad.SellerRatingCached, ad.SellerReviewCount, err = s.repo.SellerRating(ctx, userID); err != nil {
+		return nil, err
 	}
 	if err := s.repo.Create(ctx, ad); err != nil {
 		return nil, err
 	}
+	if err := s.repo.InsertPricePoint(ctx, ad.ID, price, userID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.EnqueueSavedSearchMatch(ctx, ad.ID); err != nil {
+		return nil, err
+	}
 	return ad, nil
 }
 
-// GetAd(ad_id)
-func (s *AdService) GetAd(ctx context.Context, adID string) (*model.Ad, error) {
+// GetAd(ad_id, viewer_id?)
+// Архивные, снятые с публикации и скрытые модерацией объявления видны только владельцу и администраторам.
+func (s *AdService) GetAd(ctx context.Context, adID, viewerID string) (*model.Ad, error) {
 	ad, err := s.repo.Get(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAdNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
+	if s.hiddenFrom(ad, viewerID) {
+		return nil, ErrAdNotFound
+	}
 	images, err := s.repo.ListImages(ctx, adID)
 	if err != nil {
 		return nil, err
 	}
 	// attach images to ad model for use in transport layer
 	ad.Images = images
+	if viewerID != "" {
+		fav, err := s.repo.FavoriteAdIDs(ctx, viewerID, []string{ad.ID})
+		if err != nil {
+			return nil, err
+		}
+		ad.IsFavorite = fav[ad.ID]
+	}
 	return ad, nil
 }
 
+// AdPage — страница результатов ListAds.
+type AdPage struct {
+	Ads            []model.Ad
+	Total          int
+	TotalEstimated bool   // для больших выборок Total — оценка планировщика
+	NextPageToken  string // пусто на последней странице
+}
+
 // ListAds(filters)
-func (s *AdService) ListAds(ctx context.Context, f Filters) ([]model.Ad, int, error) {
-	ads, total, err := s.repo.Search(ctx, f.Text, f.CategoryID, f.PriceMin, f.PriceMax, f.Condition, f.Limit, f.Offset)
+func (s *AdService) ListAds(ctx context.Context, f Filters) (*AdPage, error) {
+	if !repository.ValidSort(f.Sort) {
+		return nil, repository.ErrUnknownSort
+	}
+	if err := validateFilters(f); err != nil {
+		return nil, err
+	}
+	if f.Sort == repository.SortDistance && f.Near == nil {
+		return nil, fmt.Errorf("%w: sort distance requires near", ErrInvalidFilter)
+	}
+	st := f.Status
+	if st == "" {
+		st = model.StatusActive
+	}
+	if !validStatus(st) {
+		return nil, ErrInvalidStatus
+	}
+	p := repository.SearchParams{
+		Text:                 f.Text,
+		IncludeSubcategories: f.IncludeSubcategories,
+		PriceMin:             f.PriceMin,
+		PriceMax:             f.PriceMax,
+		Condition:            f.Condition,
+		MinSellerRating:      f.MinSellerRating,
+		Near:                 f.Near,
+		Limit:                f.Limit,
+		Offset:               f.Offset,
+		Cursor:               f.PageToken,
+		Sort:                 f.Sort,
+		Statuses:             []string{st},
+		// продвигаемые объявления закрепляются в начале любой выдачи по фильтрам
+		PinPromoted: true,
+	}
+	if st != model.StatusActive {
+		// неопубликованные объявления видит только владелец, в том числе скрытые модерацией
+		if f.ViewerID == "" {
+			return nil, ErrPermissionDenied
+		}
+		p.AuthorID = &f.ViewerID
+		p.IncludeHidden = true
+	}
+	if f.CategoryID != nil {
+		category, err := s.repo.GetCategory(ctx, *f.CategoryID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUnknownCategory
+		}
+		if err != nil {
+			return nil, err
+		}
+		p.CategoryID = &category.ID
+	}
+	if len(f.Attributes) > 0 {
+		// ключи характеристик имеют смысл только в пределах схемы категории
+		if p.CategoryID == nil {
+			return nil, fmt.Errorf("%w: attribute filters require category_id", ErrInvalidFilter)
+		}
+		schema, err := s.categoryAttributes(ctx, *p.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if p.Attributes, err = attributeFilters(schema, f.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if f.City != nil || f.Region != nil {
+		// фильтр по городу и региону сравнивает канонические названия из справочника
+		loc := model.Location{}
+		if f.City != nil {
+			loc.City = *f.City
+		}
+		if f.Region != nil {
+			loc.Region = *f.Region
+		}
+		if err := normalizeLocation(&loc); err != nil {
+			return nil, err
+		}
+		if f.City != nil {
+			p.City = &loc.City
+		}
+		if f.Region != nil {
+			p.Region = &loc.Region
+		}
+	}
+	return s.searchPage(ctx, p, f.ViewerID)
+}
+
+// searchPage выполняет поиск и дополняет найденные объявления изображениями и флагом избранного.
+func (s *AdService) searchPage(ctx context.Context, p repository.SearchParams, viewerID string) (*AdPage, error) {
+	res, err := s.repo.Search(ctx, p)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	ads := res.Ads
+
+	if err := s.attachImages(ctx, ads); err != nil {
+		return nil, err
 	}
+	if err := s.markFavorites(ctx, viewerID, ads); err != nil {
+		return nil, err
+	}
+
+	return &AdPage{Ads: ads, Total: res.Total, TotalEstimated: res.TotalEstimated, NextPageToken: res.NextCursor}, nil
+}
 
+// attachImages загружает изображения всех объявлений страницы одним запросом.
+func (s *AdService) attachImages(ctx context.Context, ads []model.Ad) error {
+	if len(ads) == 0 {
+		return nil
+	}
+	ids := make([]string, len(ads))
 	for i := range ads {
-		images, err := s.repo.ListImages(ctx, ads[i].ID)
-		if err != nil {
-			return nil, 0, err
-		}
-		ads[i].Images = images
+		ids[i] = ads[i].ID
+	}
+	images, err := s.repo.ListImagesByAds(ctx, ids)
+	if err != nil {
+		return err
 	}
+	for i := range ads {
+		ads[i].Images = images[ads[i].ID]
+	}
+	return nil
+}
 
-	return ads, total, nil
+// UpdateAd(ad_id, user_id, title?, description?, price?, category_id?, condition?, status?, location?, attributes?)
+// Смена статуса проверяется по таблице переходов и записывается в историю.
+// Местоположение и характеристики заменяются целиком; пустое значение их сбрасывает.
+// При смене категории характеристики (новые или текущие) проверяются по схеме новой категории.
+func (s *AdService) UpdateAd(ctx context.Context, adID, userID string, title, description *string, price *int64, categoryID, condition, status *string, loc *model.Location, attrs map[string]string) error {
+	if condition != nil && !validCondition(*condition) {
+		return ErrInvalidCondition
+	}
+	if loc != nil {
+		normalized := *loc
+		if err := normalizeLocation(&normalized); err != nil {
+			return err
+		}
+		loc = &normalized
+	}
+	return s.inTx(ctx, func(tx *AdService) error {
+		return tx.updateAd(ctx, adID, userID, title, description, price, categoryID, condition, status, loc, attrs)
+	})
 }
 
-// UpdateAd(ad_id, user_id, title?, description?, price?, category_id?, condition?, status?)
-func (s *AdService) UpdateAd(ctx context.Context, adID, userID string, title, description *string, price *int64, categoryID, condition, status *string) error {
-	return s.repo.Update(ctx, adID, userID, title, description, price, categoryID, condition, status)
+func (s *AdService) updateAd(ctx context.Context, adID, userID string, title, description *string, price *int64, categoryID, condition, status *string, loc *model.Location, attrs map[string]string) error {
+	// строка блокируется до конца транзакции: дифф ревизии считается от актуальной версии
+	current, err := s.repo.GetForUpdate(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAdNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if current.AuthorID != userID {
+		return ErrPermissionDenied
+	}
+	if status != nil {
+		if err := checkTransition(current.Status, *status); err != nil {
+			return err
+		}
+	}
+	if categoryID != nil {
+		category, err := s.resolveAdCategory(ctx, *categoryID)
+		if err != nil {
+			return err
+		}
+		categoryID = &category.ID
+	}
+	if attrs != nil || categoryID != nil && *categoryID != current.CategoryID {
+		target, values := current.CategoryID, current.Attributes
+		if categoryID != nil {
+			target = *categoryID
+		}
+		if attrs != nil {
+			values = attrs
+		}
+		schema, err := s.categoryAttributes(ctx, target)
+		if err != nil {
+			return err
+		}
+		if attrs, err = validateAttributes(schema, values); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.Update(ctx, adID, userID, title, description, price, categoryID, condition, loc, attrs); err != nil {
+		return err
+	}
+	if diff := diffAd(current, title, description, price, categoryID, condition, status, loc, attrs); len(diff) > 0 {
+		if err := s.recordRevision(ctx, adID, userID, diff); err != nil {
+			return err
+		}
+	}
+	if price != nil && *price != current.Price {
+		if err := s.recordPriceChange(ctx, adID, userID, current.Price, *price); err != nil {
+			return err
+		}
+	}
+	changes := adChanges{Title: title, Description: description, Price: price, CategoryID: categoryID, Condition: condition, Location: loc}
+	if attrs != nil && !maps.Equal(attrs, current.Attributes) {
+		changes.Attributes = &attrs
+	}
+	if !changes.empty() {
+		if err := s.emit(ctx, model.EventAdUpdated, adID, userID, changes); err != nil {
+			return err
+		}
+	}
+	if status == nil || *status == current.Status {
+		return nil
+	}
+	// повторная публикация просроченного объявления продлевает его срок
+	if *status == model.StatusActive && !current.ExpiresAt.After(time.Now()) {
+		return s.renew(ctx, current, userID)
+	}
+	if err := s.repo.ChangeStatus(ctx, adID, current.Status, *status, userID); err != nil {
+		return err
+	}
+	return s.emit(ctx, model.EventAdStatusChanged, adID, userID, statusChange{From: current.Status, To: *status})
 }
 
-// DeleteAd(ad_id, user_id)
+// DeleteAd(ad_id, user_id) — мягкое удаление: объявление пропадает из выдачи,
+// но в течение окна восстановления его можно вернуть через RestoreAd.
 func (s *AdService) DeleteAd(ctx context.Context, adID, userID string) error {
-	return s.repo.Delete(ctx, adID, userID)
+	return s.inTx(ctx, func(tx *AdService) error {
+		if err := tx.repo.Delete(ctx, adID, userID); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdDeleted, adID, userID, struct{}{})
+	})
 }
 
-// AttachMedia(ad_id, media_id)
+// AttachMedia(ad_id, media_id) добавляет изображение в конец; первое изображение становится основным.
 func (s *AdService) AttachMedia(ctx context.Context, adID, mediaID string) error {
-	return s.repo.AttachMedia(ctx, adID, mediaID)
+	return s.inTx(ctx, func(tx *AdService) error {
+		// Блокировка строки объявления, чтобы параллельные привязки не обошли предел.
+		if _, err := tx.repo.GetForUpdate(ctx, adID); errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		} else if err != nil {
+			return err
+		}
+		images, err := tx.repo.ListImages(ctx, adID)
+		if err != nil {
+			return err
+		}
+		if len(images) >= tx.maxImagesPerAd() {
+			return ErrTooManyImages
+		}
+		if err := tx.repo.AttachMedia(ctx, adID, mediaID); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdImagesChanged, adID, "", imagesChange{Attached: mediaID})
+	})
 }
 
 // DetachMedia(ad_id, media_id)
 func (s *AdService) DetachMedia(ctx context.Context, adID, mediaID string) error {
-	return s.repo.DetachMedia(ctx, adID, mediaID)
+	return s.inTx(ctx, func(tx *AdService) error {
+		if err := tx.repo.DetachMedia(ctx, adID, mediaID); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdImagesChanged, adID, "", imagesChange{Detached: mediaID})
+	})
 }
 
 // ReplaceImages(ad_id, media_ids)
 func (s *AdService) ReplaceImages(ctx context.Context, adID, userID string, mediaIDs []string) error {
-	// Авторизация по владельцу объявления (возможность добавить админа в будущем).
-	ad, err := s.repo.Get(ctx, adID)
-	if err != nil {
-		return err
-	}
-	if ad.AuthorID != userID {
-		return errors.New("not found or no permission")
-	}
-	return s.repo.ReplaceImages(ctx, adID, mediaIDs)
+	return s.inTx(ctx, func(tx *AdService) error {
+		// Авторизация по владельцу объявления (возможность добавить админа в будущем).
+		ad, err := tx.repo.GetForUpdate(ctx, adID)
+		if err != nil {
+			return err
+		}
+		if ad.AuthorID != userID {
+			return errors.New("not found or no permission")
+		}
+		if len(nonEmpty(mediaIDs)) > tx.maxImagesPerAd() {
+			return ErrTooManyImages
+		}
+		before, err := tx.repo.ListImages(ctx, adID)
+		if err != nil {
+			return err
+		}
+		if err := tx.repo.ReplaceImages(ctx, adID, mediaIDs); err != nil {
+			return err
+		}
+		if change, ok := diffImages(before, nonEmpty(mediaIDs)); ok {
+			if err := tx.recordRevision(ctx, adID, userID, []model.FieldChange{change}); err != nil {
+				return err
+			}
+		}
+		return tx.emit(ctx, model.EventAdImagesChanged, adID, userID, imagesReplaced{MediaIDs: nonEmpty(mediaIDs)})
+	})
 }
 
-func (s *AdService) CreateAdWithImages(ctx context.Context, userID, title, description string, price int64, mediaIDs []string) (*model.Ad, error) {
-	ad, err := s.CreateAd(ctx, userID, title, description, price)
-	if err != nil {
-		return nil, err
+// CreateAdWithImages создаёт объявление и привязывает изображения в одной транзакции:
+// при ошибке любой привязки не остаётся ни объявления, ни части изображений.
+func (s *AdService) CreateAdWithImages(ctx context.Context, userID, title, description string, price int64, categoryID string, loc *model.Location, attrs map[string]string, mediaIDs []string) (*model.Ad, error) {
+	if len(nonEmpty(mediaIDs)) > s.maxImagesPerAd() {
+		return nil, ErrTooManyImages
 	}
-	var attached []string
-	for _, mid := range mediaIDs {
-		if mid == "" {
-			continue
+	var ad *model.Ad
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		if ad, err = tx.createAd(ctx, userID, title, description, price, categoryID, loc, attrs); err != nil {
+			return err
 		}
-		if err := s.AttachMedia(ctx, ad.ID, mid); err != nil {
-			// Cleanup: detach any media that was already attached
-			for _, attachedMid := range attached {
-				_ = s.DetachMedia(ctx, ad.ID, attachedMid)
+		for _, mid := range nonEmpty(mediaIDs) {
+			if err := tx.repo.AttachMedia(ctx, ad.ID, mid); err != nil {
+				return err
 			}
-			// Cleanup: delete the ad
-			_ = s.DeleteAd(ctx, ad.ID, userID)
-			return nil, err
 		}
-		attached = append(attached, mid)
+		if ad.Images, err = tx.repo.ListImages(ctx, ad.ID); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdCreated, ad.ID, userID, snapshotOf(ad))
+	})
+	if err != nil {
+		return nil, err
 	}
 	return ad, nil
 }
@@ -2,24 +2,74 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+
 	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
 )
 
 type stubRepo struct {
-	createErr    error
-	deleteErr    error
-	getAd        *model.Ad
-	searchAds    []model.Ad
-	searchCnt    int
-	listImages   []model.AdImage
-	attachErr    error
-	detachErr    error
-	replaceErr   error
-	attachCalls  int
-	attachFailOn int
+	createErr     error
+	deleteErr     error
+	getAd         *model.Ad
+	searchAds     []model.Ad
+	searchCnt     int
+	listImages    []model.AdImage
+	attachErr     error
+	detachErr     error
+	replaceErr    error
+	attachCalls   int
+	attachFailOn  int
+	favorites     map[string]bool // ключ: userID + "/" + adID
+	favAds        []model.Ad
+	favCnt        int
+	reviews       []model.Review
+	reviewErr     error
+	categories    []model.Category // если пусто, GetCategory считает любую категорию существующей
+	lastSearch    repository.SearchParams
+	updatedCat    *model.Category
+	nextCursor    string
+	statusChange  []string // from, to, actor последнего ChangeStatus
+	updateCalls   int
+	renewedUntil  time.Time
+	expired       []int // результаты последовательных вызовов ArchiveExpired
+	imageBatches  int
+	txCalls       int
+	rolledBack    bool            // fn последнего InTx вернула ошибку
+	events        []model.AdEvent // outbox: все записанные события
+	published     []string
+	deletedAd     *model.Ad // результат GetDeleted
+	restored      bool
+	purged        []int // результаты последовательных вызовов PurgeDeleted
+	purgeBefore   time.Time
+	prices        []int64 // цены, записанные в историю
+	priceDrops    []int64 // новые цены, о снижении до которых разосланы уведомления
+	savedSearches []model.SavedSearch
+	queued        []string       // объявления в очереди матчера
+	matchResults  [][2]int       // результаты последовательных вызовов MatchQueuedAds: объявлений, совпадений
+	seen          []string       // сохранённые поиски, отмеченные просмотренными
+	countedViews  []model.AdView // все просмотры, переданные в CountViews
+	statDeltas    []model.AdStatDelta
+	statsErr      error // ошибка CountViews
+	dailyStats    []model.AdDailyStats
+	revisions     []model.AdRevision
+	updatedLoc    *model.Location // местоположение из последнего Update
+	updatedAttrs  map[string]string
+	attributes    []model.AttributeDef // собственные характеристики всех категорий
+	balance       int64
+	ledger        []model.LedgerEntry
+	promotedUntil *time.Time // из последнего SetPromotion
+	bumpedAt      time.Time  // из последнего BumpAd
+	reports       []model.Report
+	audit         []model.ModerationAction
+	unsettled     int // события, чьи транзакции ещё идут (для SettledEventSeq)
 }
 
 func (s *stubRepo) Create(ctx context.Context, ad *model.Ad) error {
@@ -35,11 +85,59 @@ func (s *stubRepo) Create(ctx context.Context, ad *model.Ad) error {
 	return nil
 }
 func (s *stubRepo) Get(ctx context.Context, id string) (*model.Ad, error) { return s.getAd, nil }
-func (s *stubRepo) Search(ctx context.Context, text string, categoryID *string, priceMin, priceMax *int64, condition *string, limit, offset int) ([]model.Ad, int, error) {
-	return s.searchAds, s.searchCnt, nil
+
+func (s *stubRepo) GetForUpdate(ctx context.Context, id string) (*model.Ad, error) {
+	if s.getAd == nil {
+		return nil, pgx.ErrNoRows
+	}
+	return s.getAd, nil
+}
+
+func (s *stubRepo) InsertRevision(ctx context.Context, rev *model.AdRevision) error {
+	rev.Revision = len(s.revisions) + 1
+	s.revisions = append(s.revisions, *rev)
+	return nil
+}
+
+func (s *stubRepo) ListRevisions(ctx context.Context, adID string, limit, offset int) ([]model.AdRevision, int, error) {
+	return s.revisions, len(s.revisions), nil
+}
+
+func (s *stubRepo) GetRevision(ctx context.Context, adID string, revision int) (*model.AdRevision, error) {
+	if revision < 1 || revision > len(s.revisions) {
+		return nil, pgx.ErrNoRows
+	}
+	return &s.revisions[revision-1], nil
+}
+
+func (s *stubRepo) Search(ctx context.Context, p repository.SearchParams) (*repository.SearchResult, error) {
+	s.lastSearch = p
+	return &repository.SearchResult{Ads: s.searchAds, Total: s.searchCnt, NextCursor: s.nextCursor}, nil
+}
+
+func (s *stubRepo) Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition *string, loc *model.Location, attrs map[string]string) error {
+	s.updateCalls++
+	s.updatedLoc = loc
+	s.updatedAttrs = attrs
+	return nil
 }
 
-func (s *stubRepo) Update(ctx context.Context, id string, authorID string, title, description *string, price *int64, categoryID, condition, status *string) error {
+func (s *stubRepo) RenewAd(ctx context.Context, adID, from string, expiresAt time.Time, actorID string) error {
+	s.renewedUntil = expiresAt
+	return nil
+}
+
+func (s *stubRepo) ArchiveExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	if len(s.expired) == 0 {
+		return 0, nil
+	}
+	n := s.expired[0]
+	s.expired = s.expired[1:]
+	return n, nil
+}
+
+func (s *stubRepo) ChangeStatus(ctx context.Context, adID, from, to, actorID string) error {
+	s.statusChange = []string{from, to, actorID}
 	return nil
 }
 func (s *stubRepo) Delete(ctx context.Context, id string, authorID string) error { return s.deleteErr }
@@ -58,16 +156,468 @@ func (s *stubRepo) ListImages(ctx context.Context, adID string) ([]model.AdImage
 	return s.listImages, nil
 }
 
+func (s *stubRepo) ListImagesByAds(ctx context.Context, adIDs []string) (map[string][]model.AdImage, error) {
+	s.imageBatches++
+	res := make(map[string][]model.AdImage, len(adIDs))
+	for _, id := range adIDs {
+		res[id] = s.listImages
+	}
+	return res, nil
+}
+
+func (s *stubRepo) GetDeleted(ctx context.Context, id string) (*model.Ad, error) {
+	if s.deletedAd == nil || s.restored {
+		return nil, pgx.ErrNoRows
+	}
+	return s.deletedAd, nil
+}
+
+func (s *stubRepo) Restore(ctx context.Context, id string) error {
+	s.restored = true
+	return nil
+}
+
+func (s *stubRepo) PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error) {
+	s.purgeBefore = before
+	if len(s.purged) == 0 {
+		return 0, nil
+	}
+	n := s.purged[0]
+	s.purged = s.purged[1:]
+	return n, nil
+}
+
+func (s *stubRepo) InsertEvent(ctx context.Context, ev *model.AdEvent) error {
+	ev.ID = fmt.Sprintf("ev%d", len(s.events)+1)
+	s.events = append(s.events, *ev)
+	return nil
+}
+
+// SettledEventSeq: seq события — его номер в s.events, последние unsettled ещё не закоммичены.
+func (s *stubRepo) SettledEventSeq(ctx context.Context) (int64, error) {
+	return int64(len(s.events) - s.unsettled), nil
+}
+
+func (s *stubRepo) LockPendingEvents(ctx context.Context, upTo int64, limit int) ([]model.AdEvent, error) {
+	sent := map[string]bool{}
+	for _, id := range s.published {
+		sent[id] = true
+	}
+	var res []model.AdEvent
+	for i, ev := range s.events {
+		if int64(i+1) <= upTo && !sent[ev.ID] && len(res) < limit {
+			res = append(res, ev)
+		}
+	}
+	return res, nil
+}
+
+func (s *stubRepo) MarkEventsPublished(ctx context.Context, ids []string) error {
+	s.published = append(s.published, ids...)
+	return nil
+}
+
+func (s *stubRepo) AddLedgerEntry(ctx context.Context, e *model.LedgerEntry) error {
+	if s.balance+e.Amount < 0 {
+		return repository.ErrInsufficientFunds
+	}
+	s.balance += e.Amount
+	e.BalanceAfter = s.balance
+	s.ledger = append(s.ledger, *e)
+	return nil
+}
+
+func (s *stubRepo) GetBalance(ctx context.Context, userID string) (int64, error) {
+	return s.balance, nil
+}
+
+func (s *stubRepo) ListLedger(ctx context.Context, userID string, limit, offset int) ([]model.LedgerEntry, int, error) {
+	return s.ledger, len(s.ledger), nil
+}
+
+func (s *stubRepo) SetPromotion(ctx context.Context, adID string, until time.Time) error {
+	s.promotedUntil = &until
+	return nil
+}
+
+func (s *stubRepo) BumpAd(ctx context.Context, adID string, at time.Time) error {
+	s.bumpedAt = at
+	return nil
+}
+
+func (s *stubRepo) ExpirePromotions(ctx context.Context, now time.Time, limit int) (int, error) {
+	return 0, nil
+}
+
+func (s *stubRepo) CreateReport(ctx context.Context, rp *model.Report) error {
+	for _, r := range s.reports {
+		if r.AdID == rp.AdID && r.ReporterID == rp.ReporterID && r.Status != model.ReportResolved {
+			return repository.ErrDuplicateReport
+		}
+	}
+	rp.ID = fmt.Sprintf("report-%d", len(s.reports)+1)
+	s.reports = append(s.reports, *rp)
+	return nil
+}
+
+func (s *stubRepo) CountPendingReporters(ctx context.Context, adID string) (int, error) {
+	reporters := map[string]bool{}
+	for _, r := range s.reports {
+		if r.AdID == adID && r.Status != model.ReportResolved {
+			reporters[r.ReporterID] = true
+		}
+	}
+	return len(reporters), nil
+}
+
+func (s *stubRepo) SetAdHidden(ctx context.Context, adID string, hidden bool) error {
+	if s.getAd != nil {
+		s.getAd.HiddenAt = nil
+		if hidden {
+			now := time.Now()
+			s.getAd.HiddenAt = &now
+		}
+	}
+	return nil
+}
+
+func (s *stubRepo) UpdateAdReports(ctx context.Context, adID string, from []string, to, resolution, reviewerID string) (int, error) {
+	n := 0
+	for i := range s.reports {
+		r := &s.reports[i]
+		if r.AdID == adID && slices.Contains(from, r.Status) {
+			r.Status = to
+			if to == model.ReportResolved {
+				r.Resolution, r.ReviewerID = resolution, reviewerID
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *stubRepo) GetReportForUpdate(ctx context.Context, id string) (*model.Report, error) {
+	for _, r := range s.reports {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (s *stubRepo) ResolveReport(ctx context.Context, id, resolution, reviewerID string) error {
+	for i := range s.reports {
+		if s.reports[i].ID == id {
+			s.reports[i].Status, s.reports[i].Resolution, s.reports[i].ReviewerID = model.ReportResolved, resolution, reviewerID
+		}
+	}
+	return nil
+}
+
+func (s *stubRepo) ListReports(ctx context.Context, status, adID string, limit, offset int) ([]model.Report, int, error) {
+	var list []model.Report
+	for _, r := range s.reports {
+		if (status == "" || r.Status == status) && (adID == "" || r.AdID == adID) {
+			list = append(list, r)
+		}
+	}
+	return list, len(list), nil
+}
+
+func (s *stubRepo) InsertModerationAction(ctx context.Context, a *model.ModerationAction) error {
+	s.audit = append(s.audit, *a)
+	return nil
+}
+
+func (s *stubRepo) ListModerationActions(ctx context.Context, adID string, limit, offset int) ([]model.ModerationAction, int, error) {
+	return s.audit, len(s.audit), nil
+}
+
+// InTx выполняет fn на том же стабе; откат отмечается флагом rolledBack
+// и отбрасывает события, записанные внутри fn.
+func (s *stubRepo) InTx(ctx context.Context, fn func(tx repoInterface) error) error {
+	s.txCalls++
+	n := len(s.events)
+	err := fn(s)
+	s.rolledBack = err != nil
+	if err != nil {
+		s.events = s.events[:n]
+	}
+	return err
+}
+
 func (s *stubRepo) DetachMedia(ctx context.Context, adID, mediaID string) error { return s.detachErr }
 
 func (s *stubRepo) ReplaceImages(ctx context.Context, adID string, mediaIDs []string) error {
 	return s.replaceErr
 }
 
+func (s *stubRepo) InsertPricePoint(ctx context.Context, adID string, price int64, actorID string) error {
+	s.prices = append(s.prices, price)
+	return nil
+}
+
+func (s *stubRepo) ListPriceHistory(ctx context.Context, adID string) ([]model.PricePoint, error) {
+	var points []model.PricePoint
+	for _, p := range s.prices {
+		points = append(points, model.PricePoint{Price: p})
+	}
+	return points, nil
+}
+
+func (s *stubRepo) NotifyPriceDrop(ctx context.Context, adID string, oldPrice, newPrice int64) (int, error) {
+	s.priceDrops = append(s.priceDrops, newPrice)
+	return 1, nil
+}
+
+func (s *stubRepo) LockSavedSearches(ctx context.Context, userID string) (int, error) {
+	list, err := s.ListSavedSearches(ctx, userID)
+	return len(list), err
+}
+
+func (s *stubRepo) CreateSavedSearch(ctx context.Context, ss *model.SavedSearch) error {
+	ss.ID = "ss" + strconv.Itoa(len(s.savedSearches)+1)
+	s.savedSearches = append(s.savedSearches, *ss)
+	return nil
+}
+
+func (s *stubRepo) UpdateSavedSearch(ctx context.Context, ss *model.SavedSearch) error {
+	for i := range s.savedSearches {
+		if s.savedSearches[i].ID == ss.ID && s.savedSearches[i].UserID == ss.UserID {
+			s.savedSearches[i] = *ss
+			return nil
+		}
+	}
+	return pgx.ErrNoRows
+}
+
+func (s *stubRepo) DeleteSavedSearch(ctx context.Context, id, userID string) error {
+	for i := range s.savedSearches {
+		if s.savedSearches[i].ID == id && s.savedSearches[i].UserID == userID {
+			s.savedSearches = append(s.savedSearches[:i], s.savedSearches[i+1:]...)
+			return nil
+		}
+	}
+	return pgx.ErrNoRows
+}
+
+func (s *stubRepo) GetSavedSearch(ctx context.Context, id string) (*model.SavedSearch, error) {
+	for i := range s.savedSearches {
+		if s.savedSearches[i].ID == id {
+			ss := s.savedSearches[i]
+			return &ss, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (s *stubRepo) ListSavedSearches(ctx context.Context, userID string) ([]model.SavedSearch, error) {
+	var list []model.SavedSearch
+	for _, ss := range s.savedSearches {
+		if ss.UserID == userID {
+			list = append(list, ss)
+		}
+	}
+	return list, nil
+}
+
+func (s *stubRepo) EnqueueSavedSearchMatch(ctx context.Context, adID string) error {
+	s.queued = append(s.queued, adID)
+	return nil
+}
+
+func (s *stubRepo) MatchQueuedAds(ctx context.Context, limit int) (int, int, error) {
+	if len(s.matchResults) == 0 {
+		return 0, 0, nil
+	}
+	r := s.matchResults[0]
+	s.matchResults = s.matchResults[1:]
+	return r[0], r[1], nil
+}
+
+// ListSavedSearchMatches отдаёт страницу s.searchAds; все совпадения найдены в момент time.Unix(2000, 0).
+func (s *stubRepo) ListSavedSearchMatches(ctx context.Context, searchID string, since time.Time, limit, offset int) ([]model.Ad, int, time.Time, error) {
+	var newest time.Time
+	if len(s.searchAds) > 0 {
+		newest = time.Unix(2000, 0)
+	}
+	page := s.searchAds[min(offset, len(s.searchAds)):]
+	return page[:min(limit, len(page))], len(s.searchAds), newest, nil
+}
+
+func (s *stubRepo) MarkSavedSearchSeen(ctx context.Context, searchID string, since, upTo time.Time) error {
+	s.seen = append(s.seen, searchID)
+	return nil
+}
+
+func (s *stubRepo) CountViews(ctx context.Context, views []model.AdView, window time.Duration) (int, error) {
+	if s.statsErr != nil {
+		return 0, s.statsErr
+	}
+	s.countedViews = append(s.countedViews, views...)
+	return len(views), nil
+}
+
+func (s *stubRepo) AddDailyStats(ctx context.Context, deltas []model.AdStatDelta) error {
+	s.statDeltas = append(s.statDeltas, deltas...)
+	return nil
+}
+
+func (s *stubRepo) PurgeViewDedup(ctx context.Context, before time.Time) (int, error) { return 0, nil }
+
+func (s *stubRepo) ListDailyStats(ctx context.Context, adID string, since time.Time) ([]model.AdDailyStats, error) {
+	return s.dailyStats, nil
+}
+
+func (s *stubRepo) SellerStats(ctx context.Context, authorID string, since time.Time, limit, offset int) ([]model.AdStatsSummary, int, error) {
+	return nil, 0, nil
+}
+
+func (s *stubRepo) ReorderImages(ctx context.Context, adID string, imageIDs []string) error {
+	byID := map[string]model.AdImage{}
+	for _, img := range s.listImages {
+		byID[img.ID] = img
+	}
+	s.listImages = s.listImages[:0:0]
+	for i, id := range imageIDs {
+		img := byID[id]
+		img.Position = i + 1
+		s.listImages = append(s.listImages, img)
+	}
+	return nil
+}
+
+func (s *stubRepo) SetPrimaryImage(ctx context.Context, adID, imageID string) error {
+	found := false
+	for i := range s.listImages {
+		s.listImages[i].IsPrimary = s.listImages[i].ID == imageID
+		found = found || s.listImages[i].IsPrimary
+	}
+	if !found {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func (s *stubRepo) AddFavorite(ctx context.Context, userID, adID string) (bool, error) {
+	if s.favorites == nil {
+		s.favorites = map[string]bool{}
+	}
+	added := !s.favorites[userID+"/"+adID]
+	s.favorites[userID+"/"+adID] = true
+	return added, nil
+}
+
+func (s *stubRepo) RemoveFavorite(ctx context.Context, userID, adID string) error {
+	if !s.favorites[userID+"/"+adID] {
+		return errors.New("favorite not found")
+	}
+	delete(s.favorites, userID+"/"+adID)
+	return nil
+}
+
+func (s *stubRepo) ListFavorites(ctx context.Context, userID string, limit, offset int) ([]model.Ad, int, error) {
+	return s.favAds, s.favCnt, nil
+}
+
+func (s *stubRepo) FavoriteAdIDs(ctx context.Context, userID string, adIDs []string) (map[string]bool, error) {
+	res := map[string]bool{}
+	for _, id := range adIDs {
+		if s.favorites[userID+"/"+id] {
+			res[id] = true
+		}
+	}
+	return res, nil
+}
+
+func (s *stubRepo) CreateReview(ctx context.Context, rv *model.Review, sellerID string) error {
+	if s.reviewErr != nil {
+		return s.reviewErr
+	}
+	rv.ID = "review-id"
+	s.reviews = append(s.reviews, *rv)
+	return nil
+}
+
+func (s *stubRepo) SellerRating(ctx context.Context, sellerID string) (*float64, int, error) {
+	if len(s.reviews) == 0 {
+		return nil, 0, nil
+	}
+	var sum float64
+	for _, rv := range s.reviews {
+		sum += float64(rv.Rating)
+	}
+	avg := sum / float64(len(s.reviews))
+	return &avg, len(s.reviews), nil
+}
+
+func (s *stubRepo) DeleteReview(ctx context.Context, reviewID, reviewerID string) error {
+	return s.reviewErr
+}
+
+func (s *stubRepo) ListReviewsByAd(ctx context.Context, adID string, limit, offset int) ([]model.Review, int, error) {
+	return s.reviews, len(s.reviews), nil
+}
+
+func (s *stubRepo) ListReviewsBySeller(ctx context.Context, sellerID string, limit, offset int) ([]model.Review, int, error) {
+	return s.reviews, len(s.reviews), nil
+}
+
+func (s *stubRepo) ListCategories(ctx context.Context, includeArchived bool) ([]model.Category, error) {
+	var list []model.Category
+	for _, c := range s.categories {
+		if includeArchived || !c.Archived {
+			list = append(list, c)
+		}
+	}
+	return list, nil
+}
+
+func (s *stubRepo) GetCategory(ctx context.Context, idOrSlug string) (*model.Category, error) {
+	if len(s.categories) == 0 {
+		return &model.Category{ID: idOrSlug, Slug: idOrSlug, Name: idOrSlug}, nil
+	}
+	for _, c := range s.categories {
+		if c.ID == idOrSlug || c.Slug == idOrSlug {
+			return &c, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (s *stubRepo) CreateCategory(ctx context.Context, c *model.Category) error {
+	c.ID = "new-cat"
+	s.categories = append(s.categories, *c)
+	return nil
+}
+
+func (s *stubRepo) UpdateCategory(ctx context.Context, c *model.Category) error {
+	s.updatedCat = c
+	return nil
+}
+
+func (s *stubRepo) ArchiveCategory(ctx context.Context, id string) error { return nil }
+
+func (s *stubRepo) ListCategoryAttributes(ctx context.Context, categoryIDs []string) ([]model.AttributeDef, error) {
+	var res []model.AttributeDef
+	for _, d := range s.attributes {
+		if slices.Contains(categoryIDs, d.CategoryID) {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+func (s *stubRepo) ReplaceCategoryAttributes(ctx context.Context, categoryID string, defs []model.AttributeDef) error {
+	s.attributes = slices.DeleteFunc(s.attributes, func(d model.AttributeDef) bool { return d.CategoryID == categoryID })
+	s.attributes = append(s.attributes, defs...)
+	return nil
+}
+
 func TestCreateAd(t *testing.T) {
 	repo := &stubRepo{}
 	svc := &AdService{repo: repo}
-	ad, err := svc.CreateAd(context.Background(), "author-1", "Title", "Desc", 123)
+	ad, err := svc.CreateAd(context.Background(), "author-1", "Title", "Desc", 123, "", nil, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -85,11 +635,26 @@ func TestCreateAd(t *testing.T) {
 	}
 }
 
+func TestCreateAd_CopiesSellerRating(t *testing.T) {
+	repo := &stubRepo{reviews: []model.Review{{ID: "r1", Rating: 4}, {ID: "r2", Rating: 5}}}
+	svc := &AdService{repo: repo}
+	ad, err := svc.CreateAd(context.Background(), "author-1", "Title", "Desc", 123, "", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ad.SellerRatingCached == nil || *ad.SellerRatingCached != 4.5 {
+		t.Errorf("expected cached rating 4.5, got %v", ad.SellerRatingCached)
+	}
+	if ad.SellerReviewCount != 2 {
+		t.Errorf("expected review count 2, got %d", ad.SellerReviewCount)
+	}
+}
+
 func TestGetAd(t *testing.T) {
 	expected := &model.Ad{ID: "x", Title: "T"}
 	repo := &stubRepo{getAd: expected, listImages: []model.AdImage{{ID: "img1", AdID: "x", URL: "http://example/img1.jpg"}}}
 	svc := &AdService{repo: repo}
-	ad, err := svc.GetAd(context.Background(), "x")
+	ad, err := svc.GetAd(context.Background(), "x", "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -105,10 +670,11 @@ func TestListAds(t *testing.T) {
 	list := []model.Ad{{ID: "1"}, {ID: "2"}}
 	repo := &stubRepo{searchAds: list, searchCnt: 2, listImages: []model.AdImage{{ID: "imgX", AdID: "1", URL: "http://example/x.jpg"}}}
 	svc := &AdService{repo: repo}
-	ads, cnt, err := svc.ListAds(context.Background(), Filters{Limit: 10, Offset: 0})
+	page, err := svc.ListAds(context.Background(), Filters{Limit: 10, Offset: 0})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	ads, cnt := page.Ads, page.Total
 	if cnt != 2 {
 		t.Errorf("expected count 2 got %d", cnt)
 	}
@@ -120,6 +686,9 @@ func TestListAds(t *testing.T) {
 			t.Errorf("expected images attached to listed ads")
 		}
 	}
+	if repo.imageBatches != 1 {
+		t.Errorf("expected one batch image query, got %d", repo.imageBatches)
+	}
 }
 
 func TestDeleteAd(t *testing.T) {
@@ -131,16 +700,16 @@ func TestDeleteAd(t *testing.T) {
 }
 
 func TestUpdateAd(t *testing.T) {
-	repo := &stubRepo{}
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1", Title: "Old"}}
 	svc := &AdService{repo: repo}
 	title := "New"
-	if err := svc.UpdateAd(context.Background(), "ad1", "author-1", &title, nil, nil, nil, nil, nil); err != nil {
+	if err := svc.UpdateAd(context.Background(), "ad1", "author-1", &title, nil, nil, nil, nil, nil, nil, nil); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
 func TestAttachMedia(t *testing.T) {
-	repo := &stubRepo{}
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}}
 	svc := &AdService{repo: repo}
 	if err := svc.AttachMedia(context.Background(), "ad1", "media-123"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -150,7 +719,7 @@ func TestAttachMedia(t *testing.T) {
 func TestCreateAd_Error(t *testing.T) {
 	repo := &stubRepo{createErr: context.Canceled}
 	svc := &AdService{repo: repo}
-	if _, err := svc.CreateAd(context.Background(), "author-1", "Title", "Desc", 123); err == nil {
+	if _, err := svc.CreateAd(context.Background(), "author-1", "Title", "Desc", 123, "", nil, nil); err == nil {
 		t.Fatalf("expected error from CreateAd")
 	}
 }
@@ -179,26 +748,68 @@ func TestReplaceImages_Success(t *testing.T) {
 	if err := svc.ReplaceImages(context.Background(), "ad1", "author-1", []string{"m1"}); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if repo.txCalls != 1 {
+		t.Errorf("expected ownership check and replacement in one transaction")
+	}
 }
 
 func TestCreateAdWithImages_Success(t *testing.T) {
 	repo := &stubRepo{listImages: nil}
 	svc := &AdService{repo: repo}
-	ad, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, []string{"m1", "m2"})
+	ad, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, "", nil, nil, []string{"m1", "m2"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if ad == nil || ad.ID == "" {
 		t.Fatalf("ad should be created with ID")
 	}
+	if repo.txCalls != 1 || repo.rolledBack || repo.attachCalls != 2 {
+		t.Errorf("expected ad and images committed in one transaction")
+	}
 }
 
 func TestCreateAdWithImages_AttachFail_CleansUp(t *testing.T) {
 	// Simulate attach failing on second media; ensure cleanup paths execute without panic
 	repo := &stubRepo{attachErr: context.Canceled, attachFailOn: 2}
 	svc := &AdService{repo: repo}
-	_, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, []string{"m1", "m2", "m3"})
+	_, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, "", nil, nil, []string{"m1", "m2", "m3"})
 	if err == nil {
 		t.Fatalf("expected error from attach failure")
 	}
+	if repo.txCalls != 1 || !repo.rolledBack {
+		t.Errorf("expected the whole operation to be rolled back in one transaction")
+	}
+}
+
+func TestListAds_PassesPageToken(t *testing.T) {
+	repo := &stubRepo{searchAds: []model.Ad{{ID: "1"}}, searchCnt: 25, nextCursor: "next"}
+	svc := &AdService{repo: repo}
+	page, err := svc.ListAds(context.Background(), Filters{Limit: 1, PageToken: "cur"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.Cursor != "cur" {
+		t.Errorf("expected cursor passed to repository, got %q", repo.lastSearch.Cursor)
+	}
+	if page.Total != 25 || page.NextPageToken != "next" {
+		t.Errorf("unexpected page metadata: total=%d next=%q", page.Total, page.NextPageToken)
+	}
+}
+
+func TestListAds_Sort(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	if _, err := svc.ListAds(context.Background(), Filters{Sort: repository.SortPriceAsc}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.Sort != repository.SortPriceAsc {
+		t.Errorf("expected sort passed to repository, got %q", repo.lastSearch.Sort)
+	}
+	repo.lastSearch = repository.SearchParams{}
+	if _, err := svc.ListAds(context.Background(), Filters{Sort: "cheapest"}); !errors.Is(err, repository.ErrUnknownSort) {
+		t.Fatalf("expected ErrUnknownSort, got %v", err)
+	}
+	if repo.lastSearch.Sort != "" {
+		t.Errorf("repository should not be queried for an unknown sort")
+	}
 }
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidAttributes      = errors.New("invalid ad attributes")
+	ErrInvalidAttributeSchema = errors.New("invalid category attribute schema")
+)
+
+const (
+	maxAttributesPerCategory = 50
+	maxAttributeOptions      = 200
+	maxAttributeName         = 100
+	// maxAttributeText ограничивает значения text и варианты enum.
+	maxAttributeText = 200
+	// maxAttributeNumber — предел модуля чисел: больше в numeric-фильтрах без потери точности не нужно.
+	maxAttributeNumber = 1e15
+)
+
+var attributeKeyRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// categoryAttributes возвращает действующую схему категории: характеристики предков,
+// затем собственные; при совпадении ключа побеждает ближайшая категория.
+func (s *AdService) categoryAttributes(ctx context.Context, categoryID string) ([]model.AttributeDef, error) {
+	var chain []string // от категории к корню
+	for id, depth := categoryID, 0; id != "" && depth < maxCategoryDepth; depth++ {
+		c, err := s.repo.GetCategory(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, c.ID)
+		if c.ParentID == nil {
+			break
+		}
+		id = *c.ParentID
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	own, err := s.repo.ListCategoryAttributes(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	byCategory := make(map[string][]model.AttributeDef, len(chain))
+	for _, d := range own {
+		byCategory[d.CategoryID] = append(byCategory[d.CategoryID], d)
+	}
+	var schema []model.AttributeDef
+	index := map[string]int{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, d := range byCategory[chain[i]] {
+			if j, ok := index[d.Key]; ok {
+				schema[j] = d
+				continue
+			}
+			index[d.Key] = len(schema)
+			schema = append(schema, d)
+		}
+	}
+	return schema, nil
+}
+
+// GetCategoryAttributes(category_id) — действующая схема характеристик категории с учётом родителей.
+func (s *AdService) GetCategoryAttributes(ctx context.Context, categoryID string) ([]model.AttributeDef, error) {
+	c, err := s.repo.GetCategory(ctx, categoryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUnknownCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.categoryAttributes(ctx, c.ID)
+}
+
+// SetCategoryAttributes(user_id, category_id, attributes) заменяет собственные характеристики
+// категории, только для администраторов. Значения в существующих объявлениях не меняются;
+// новая схема проверяется при следующем их изменении.
+func (s *AdService) SetCategoryAttributes(ctx context.Context, userID, categoryID string, defs []model.AttributeDef) ([]model.AttributeDef, error) {
+	if !s.isAdmin(userID) {
+		return nil, ErrPermissionDenied
+	}
+	c, err := s.repo.GetCategory(ctx, categoryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUnknownCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(defs) > maxAttributesPerCategory {
+		return nil, fmt.Errorf("%w: at most %d attributes", ErrInvalidAttributeSchema, maxAttributesPerCategory)
+	}
+	seen := map[string]bool{}
+	for i := range defs {
+		d := &defs[i]
+		d.CategoryID, d.Position = c.ID, i+1
+		d.Name = strings.TrimSpace(d.Name)
+		if err := validateAttributeDef(d); err != nil {
+			return nil, err
+		}
+		if seen[d.Key] {
+			return nil, fmt.Errorf("%w: duplicate key %q", ErrInvalidAttributeSchema, d.Key)
+		}
+		seen[d.Key] = true
+	}
+	if err := s.repo.ReplaceCategoryAttributes(ctx, c.ID, defs); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+func validateAttributeDef(d *model.AttributeDef) error {
+	if !attributeKeyRe.MatchString(d.Key) {
+		return fmt.Errorf("%w: key %q must match [a-z][a-z0-9_]*", ErrInvalidAttributeSchema, d.Key)
+	}
+	if d.Name == "" || utf8.RuneCountInString(d.Name) > maxAttributeName {
+		return fmt.Errorf("%w: name of %q must be 1..%d characters", ErrInvalidAttributeSchema, d.Key, maxAttributeName)
+	}
+	switch d.Type {
+	case model.AttributeEnum:
+		if len(d.Options) == 0 || len(d.Options) > maxAttributeOptions {
+			return fmt.Errorf("%w: enum %q needs 1..%d options", ErrInvalidAttributeSchema, d.Key, maxAttributeOptions)
+		}
+		for i, o := range d.Options {
+			o = strings.TrimSpace(o)
+			if o == "" || utf8.RuneCountInString(o) > maxAttributeText || slices.Contains(d.Options[:i], o) {
+				return fmt.Errorf("%w: options of %q must be unique non-empty strings", ErrInvalidAttributeSchema, d.Key)
+			}
+			d.Options[i] = o
+		}
+	case model.AttributeNumber, model.AttributeBool, model.AttributeText:
+		if len(d.Options) > 0 {
+			return fmt.Errorf("%w: only enum attributes have options", ErrInvalidAttributeSchema)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q of %q", ErrInvalidAttributeSchema, d.Type, d.Key)
+	}
+	return nil
+}
+
+// normalizeAttributeValue проверяет значение по типу характеристики и приводит его к каноническому виду.
+func normalizeAttributeValue(d model.AttributeDef, v string) (string, error) {
+	v = strings.TrimSpace(v)
+	switch d.Type {
+	case model.AttributeEnum:
+		if !slices.Contains(d.Options, v) {
+			return "", fmt.Errorf("%w: %s must be one of %s", ErrInvalidAttributes, d.Key, strings.Join(d.Options, ", "))
+		}
+	case model.AttributeNumber:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil || math.IsNaN(n) || math.Abs(n) > maxAttributeNumber {
+			return "", fmt.Errorf("%w: %s must be a number", ErrInvalidAttributes, d.Key)
+		}
+		v = strconv.FormatFloat(n, 'f', -1, 64)
+	case model.AttributeBool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be true or false", ErrInvalidAttributes, d.Key)
+		}
+		v = strconv.FormatBool(b)
+	case model.AttributeText:
+		if utf8.RuneCountInString(v) > maxAttributeText {
+			return "", fmt.Errorf("%w: %s is longer than %d characters", ErrInvalidAttributes, d.Key, maxAttributeText)
+		}
+	}
+	return v, nil
+}
+
+// validateAttributes проверяет значения по схеме категории и возвращает их в каноническом виде.
+// Пустые значения считаются незаданными.
+func validateAttributes(schema []model.AttributeDef, values map[string]string) (map[string]string, error) {
+	defs := make(map[string]model.AttributeDef, len(schema))
+	for _, d := range schema {
+		defs[d.Key] = d
+	}
+	res := make(map[string]string, len(values))
+	for k, v := range values {
+		d, ok := defs[k]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown attribute %q", ErrInvalidAttributes, k)
+		}
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		nv, err := normalizeAttributeValue(d, v)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = nv
+	}
+	for _, d := range schema {
+		if _, ok := res[d.Key]; d.Required && !ok {
+			return nil, fmt.Errorf("%w: %s is required", ErrInvalidAttributes, d.Key)
+		}
+	}
+	return res, nil
+}
+
+// attributeFilters проверяет фильтры ListAds по схеме категории: равенство — для enum, bool
+// и text, диапазон — только для number.
+func attributeFilters(schema []model.AttributeDef, filters []model.AttributeFilter) ([]model.AttributeFilter, error) {
+	defs := make(map[string]model.AttributeDef, len(schema))
+	for _, d := range schema {
+		defs[d.Key] = d
+	}
+	res := make([]model.AttributeFilter, 0, len(filters))
+	for _, f := range filters {
+		d, ok := defs[f.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: category has no attribute %q", ErrInvalidFilter, f.Key)
+		}
+		isRange := f.Min != nil || f.Max != nil
+		switch {
+		case f.Equals == nil && !isRange:
+			return nil, fmt.Errorf("%w: attribute filter %q has no condition", ErrInvalidFilter, f.Key)
+		case d.Type == model.AttributeNumber && f.Equals != nil,
+			d.Type != model.AttributeNumber && isRange:
+			return nil, fmt.Errorf("%w: %s attribute %q supports only %s", ErrInvalidFilter, d.Type, f.Key, filterKind(d.Type))
+		case f.Min != nil && f.Max != nil && *f.Min > *f.Max:
+			return nil, fmt.Errorf("%w: min of %q is greater than max", ErrInvalidFilter, f.Key)
+		}
+		if f.Equals != nil {
+			v, err := normalizeAttributeValue(d, *f.Equals)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+			}
+			f.Equals = &v
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+func filterKind(typ string) string {
+	if typ == model.AttributeNumber {
+		return "min/max"
+	}
+	return "equality"
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// attributesRepo — «Электроника» с обязательной маркой и дочерние «Телефоны» с памятью и флагом разблокировки.
+func attributesRepo() *stubRepo {
+	electronics := "electronics"
+	return &stubRepo{
+		categories: []model.Category{
+			{ID: DefaultCategoryID, Slug: "misc", Name: "Разное"},
+			{ID: electronics, Slug: electronics, Name: "Электроника"},
+			{ID: "phones", Slug: "phones", Name: "Телефоны", ParentID: &electronics},
+		},
+		attributes: []model.AttributeDef{
+			{CategoryID: electronics, Key: "brand", Name: "Марка", Type: model.AttributeEnum, Required: true, Options: []string{"Apple", "Samsung"}, Position: 1},
+			{CategoryID: electronics, Key: "color", Name: "Цвет", Type: model.AttributeText, Position: 2},
+			{CategoryID: "phones", Key: "memory_gb", Name: "Память, ГБ", Type: model.AttributeNumber, Position: 1},
+			{CategoryID: "phones", Key: "unlocked", Name: "Без привязки к оператору", Type: model.AttributeBool, Position: 2},
+		},
+	}
+}
+
+func TestCreateAd_ValidatesAttributes(t *testing.T) {
+	svc := &AdService{repo: attributesRepo()}
+	ad, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "phones", nil, map[string]string{
+		"brand": "Apple", "memory_gb": "064.0", "unlocked": "1", "color": " ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"brand": "Apple", "memory_gb": "64", "unlocked": "true"}
+	if len(ad.Attributes) != len(want) {
+		t.Fatalf("got %v, want %v", ad.Attributes, want)
+	}
+	for k, v := range want {
+		if ad.Attributes[k] != v {
+			t.Errorf("%s: got %q, want %q", k, ad.Attributes[k], v)
+		}
+	}
+
+	bad := map[string]map[string]string{
+		"missing required": {"memory_gb": "64"},
+		"unknown key":      {"brand": "Apple", "mileage": "1000"},
+		"not an option":    {"brand": "Nokia"},
+		"not a number":     {"brand": "Apple", "memory_gb": "много"},
+		"not a bool":       {"brand": "Apple", "unlocked": "maybe"},
+	}
+	for name, attrs := range bad {
+		if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "phones", nil, attrs); !errors.Is(err, ErrInvalidAttributes) {
+			t.Errorf("%s: expected ErrInvalidAttributes, got %v", name, err)
+		}
+	}
+	// у категории без схемы характеристик нет
+	if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", nil, map[string]string{"brand": "Apple"}); !errors.Is(err, ErrInvalidAttributes) {
+		t.Errorf("expected ErrInvalidAttributes for category without schema, got %v", err)
+	}
+}
+
+func TestUpdateAd_CategoryChangeRevalidatesAttributes(t *testing.T) {
+	repo := attributesRepo()
+	repo.getAd = &model.Ad{ID: "ad1", AuthorID: "u1", CategoryID: DefaultCategoryID, Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour)}
+	svc := &AdService{repo: repo}
+	phones := "phones"
+	// в новой категории марка обязательна
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", nil, nil, nil, &phones, nil, nil, nil, nil); !errors.Is(err, ErrInvalidAttributes) {
+		t.Fatalf("expected ErrInvalidAttributes, got %v", err)
+	}
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", nil, nil, nil, &phones, nil, nil, nil, map[string]string{"brand": "Samsung", "memory_gb": "128"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.updatedAttrs["brand"] != "Samsung" || repo.updatedAttrs["memory_gb"] != "128" {
+		t.Errorf("expected attributes in repository, got %v", repo.updatedAttrs)
+	}
+	changes := repo.revisions[len(repo.revisions)-1].Changes
+	if last := changes[len(changes)-1]; last.Field != "attributes" || last.Old != "" || last.New != "brand=Samsung\nmemory_gb=128" {
+		t.Errorf("unexpected attributes revision %+v", last)
+	}
+}
+
+func TestSetCategoryAttributes(t *testing.T) {
+	repo := attributesRepo()
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	defs := []model.AttributeDef{
+		{Key: "memory_gb", Name: "Встроенная память", Type: model.AttributeEnum, Options: []string{" 64", "128"}},
+		{Key: "sim_count", Name: "SIM-карт", Type: model.AttributeNumber, Required: true},
+	}
+	if _, err := svc.SetCategoryAttributes(context.Background(), "u1", "phones", defs); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	got, err := svc.SetCategoryAttributes(context.Background(), "admin", "phones", defs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got[1].Position != 2 || got[0].Options[0] != "64" || got[0].CategoryID != "phones" {
+		t.Errorf("expected positions, category and trimmed options, got %+v", got)
+	}
+
+	// предки первыми, собственная характеристика подменяет одноимённую
+	schema, err := svc.GetCategoryAttributes(context.Background(), "phones")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var keys []string
+	for _, d := range schema {
+		keys = append(keys, d.Key)
+	}
+	if len(keys) != 4 || keys[0] != "brand" || keys[1] != "color" || keys[2] != "memory_gb" || keys[3] != "sim_count" || schema[2].Type != model.AttributeEnum {
+		t.Errorf("unexpected schema %v", schema)
+	}
+
+	invalid := map[string][]model.AttributeDef{
+		"bad key":         {{Key: "Memory", Name: "M", Type: model.AttributeNumber}},
+		"empty name":      {{Key: "memory", Name: " ", Type: model.AttributeNumber}},
+		"unknown type":    {{Key: "memory", Name: "M", Type: "date"}},
+		"enum no options": {{Key: "memory", Name: "M", Type: model.AttributeEnum}},
+		"options on text": {{Key: "memory", Name: "M", Type: model.AttributeText, Options: []string{"a"}}},
+		"duplicate":       {{Key: "a", Name: "A", Type: model.AttributeBool}, {Key: "a", Name: "B", Type: model.AttributeBool}},
+	}
+	for name, defs := range invalid {
+		if _, err := svc.SetCategoryAttributes(context.Background(), "admin", "phones", defs); !errors.Is(err, ErrInvalidAttributeSchema) {
+			t.Errorf("%s: expected ErrInvalidAttributeSchema, got %v", name, err)
+		}
+	}
+}
+
+func TestListAds_AttributeFilters(t *testing.T) {
+	repo := attributesRepo()
+	svc := &AdService{repo: repo}
+	phones := "phones"
+	apple, yes, min, max := "Apple", "1", 64.0, 256.0
+	filters := []model.AttributeFilter{{Key: "brand", Equals: &apple}, {Key: "unlocked", Equals: &yes}, {Key: "memory_gb", Min: &min, Max: &max}}
+	if _, err := svc.ListAds(context.Background(), Filters{CategoryID: &phones, IncludeSubcategories: true, Attributes: filters}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := repo.lastSearch.Attributes
+	if len(got) != 3 || *got[1].Equals != "true" || *got[2].Min != 64 {
+		t.Errorf("expected normalized filters in repository, got %+v", got)
+	}
+
+	invalid := map[string]Filters{
+		"no category":   {Attributes: filters[:1]},
+		"unknown key":   {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "mileage", Min: &min}}},
+		"range on enum": {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "brand", Min: &min}}},
+		"equals number": {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "memory_gb", Equals: &yes}}},
+		"min over max":  {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "memory_gb", Min: &max, Max: &min}}},
+		"not an option": {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "brand", Equals: &yes}}},
+		"no condition":  {CategoryID: &phones, Attributes: []model.AttributeFilter{{Key: "brand"}}},
+	}
+	for name, f := range invalid {
+		if _, err := svc.ListAds(context.Background(), f); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%s: expected ErrInvalidFilter, got %v", name, err)
+		}
+	}
+}
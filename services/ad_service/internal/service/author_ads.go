@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+// ListAdsByAuthor(author_id, viewer_id?, status?, limit, offset, page_token?) — объявления продавца
+// для страницы профиля, новые первыми. Владелец и администраторы видят все статусы
+// и скрытые модерацией объявления, остальные — только публичные (ACTIVE и SOLD).
+func (s *AdService) ListAdsByAuthor(ctx context.Context, authorID, viewerID, status string, limit, offset int, pageToken string) (*AdPage, error) {
+	if status != "" && !validStatus(status) {
+		return nil, ErrInvalidStatus
+	}
+	owner := viewerID != "" && (viewerID == authorID || s.isAdmin(viewerID))
+	var statuses []string
+	switch {
+	case status != "" && ownerOnly(status) && !owner:
+		return nil, ErrPermissionDenied
+	case status != "":
+		statuses = []string{status}
+	case !owner:
+		statuses = publicStatuses
+	}
+	p := repository.SearchParams{
+		AuthorID:      &authorID,
+		Statuses:      statuses,
+		Limit:         limit,
+		Offset:        offset,
+		Cursor:        pageToken,
+		Sort:          repository.SortNewest,
+		IncludeHidden: owner,
+	}
+	return s.searchPage(ctx, p, viewerID)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestListAdsByAuthor_Visibility(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	ctx := context.Background()
+
+	if _, err := svc.ListAdsByAuthor(ctx, "seller", "", "", 10, 0, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(repo.lastSearch.Statuses, publicStatuses) || *repo.lastSearch.AuthorID != "seller" {
+		t.Errorf("anonymous viewer must get public ads of the seller, got %+v", repo.lastSearch)
+	}
+
+	for _, viewer := range []string{"seller", "admin"} {
+		if _, err := svc.ListAdsByAuthor(ctx, "seller", viewer, "", 10, 0, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if repo.lastSearch.Statuses != nil {
+			t.Errorf("%s must see all statuses, got %v", viewer, repo.lastSearch.Statuses)
+		}
+	}
+
+	if _, err := svc.ListAdsByAuthor(ctx, "seller", "other", model.StatusArchived, 10, 0, ""); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for archived ads of another user, got %v", err)
+	}
+	if _, err := svc.ListAdsByAuthor(ctx, "seller", "other", model.StatusSold, 10, 0, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(repo.lastSearch.Statuses, []string{model.StatusSold}) {
+		t.Errorf("expected SOLD filter, got %v", repo.lastSearch.Statuses)
+	}
+}
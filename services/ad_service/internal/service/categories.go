@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// DefaultCategoryID — категория «Разное», используется, если при создании объявления категория не указана.
+const DefaultCategoryID = "00000000-0000-0000-0000-000000000000"
+
+var (
+	ErrUnknownCategory  = errors.New("unknown category")
+	ErrArchivedCategory = errors.New("category is archived")
+	ErrInvalidCategory  = errors.New("category slug must match [a-z0-9-] and name must not be empty")
+	ErrCategoryCycle    = errors.New("category cannot be moved under itself or its descendant")
+	ErrInvalidLifetime  = errors.New("ad lifetime must be a positive number of days")
+)
+
+var slugRe = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// resolveAdCategory возвращает активную категорию для объявления по id или slug.
+func (s *AdService) resolveAdCategory(ctx context.Context, ref string) (*model.Category, error) {
+	if ref == "" {
+		ref = DefaultCategoryID
+	}
+	c, err := s.repo.GetCategory(ctx, ref)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUnknownCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.Archived {
+		return nil, ErrArchivedCategory
+	}
+	return c, nil
+}
+
+// ListCategories возвращает дерево категорий: корневые категории с вложенными Children.
+func (s *AdService) ListCategories(ctx context.Context, includeArchived bool) ([]model.Category, error) {
+	list, err := s.repo.ListCategories(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(list), nil
+}
+
+// buildCategoryTree собирает дерево из плоского списка, сохраняя порядок списка.
+// Категории, чей родитель отсутствует в списке (например, архивный), не попадают в дерево.
+func buildCategoryTree(list []model.Category) []model.Category {
+	children := make(map[string][]int, len(list))
+	present := make(map[string]bool, len(list))
+	for _, c := range list {
+		present[c.ID] = true
+	}
+	var roots []int
+	for i, c := range list {
+		switch {
+		case c.ParentID == nil:
+			roots = append(roots, i)
+		case present[*c.ParentID]:
+			children[*c.ParentID] = append(children[*c.ParentID], i)
+		}
+	}
+	var build func(i int) model.Category
+	build = func(i int) model.Category {
+		c := list[i]
+		c.Children = nil
+		for _, ci := range children[c.ID] {
+			c.Children = append(c.Children, build(ci))
+		}
+		return c
+	}
+	tree := make([]model.Category, 0, len(roots))
+	for _, i := range roots {
+		tree = append(tree, build(i))
+	}
+	return tree
+}
+
+// CreateCategory(user_id, slug, name, parent_id?, ad_lifetime_days?) — только для администраторов.
+// Нулевой срок жизни означает «как у родителя».
+func (s *AdService) CreateCategory(ctx context.Context, userID, slug, name, parentID string, lifetimeDays int) (*model.Category, error) {
+	if !s.isAdmin(userID) {
+		return nil, ErrPermissionDenied
+	}
+	c := &model.Category{Slug: strings.TrimSpace(slug), Name: strings.TrimSpace(name)}
+	if !slugRe.MatchString(c.Slug) || c.Name == "" {
+		return nil, ErrInvalidCategory
+	}
+	if lifetimeDays < 0 {
+		return nil, ErrInvalidLifetime
+	}
+	if lifetimeDays > 0 {
+		c.AdLifetimeDays = &lifetimeDays
+	}
+	if parentID != "" {
+		parent, err := s.resolveAdCategory(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		c.ParentID = &parent.ID
+	}
+	if err := s.repo.CreateCategory(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UpdateCategory(user_id, id, slug?, name?, parent_id?, ad_lifetime_days?) — только для администраторов.
+// Пустой parent_id делает категорию корневой, нулевой срок жизни — наследуемым от родителя.
+func (s *AdService) UpdateCategory(ctx context.Context, userID, id string, slug, name, parentID *string, lifetimeDays *int) (*model.Category, error) {
+	if !s.isAdmin(userID) {
+		return nil, ErrPermissionDenied
+	}
+	c, err := s.repo.GetCategory(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUnknownCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+	if slug != nil {
+		c.Slug = strings.TrimSpace(*slug)
+	}
+	if name != nil {
+		c.Name = strings.TrimSpace(*name)
+	}
+	if !slugRe.MatchString(c.Slug) || c.Name == "" {
+		return nil, ErrInvalidCategory
+	}
+	if lifetimeDays != nil {
+		switch {
+		case *lifetimeDays < 0:
+			return nil, ErrInvalidLifetime
+		case *lifetimeDays == 0:
+			c.AdLifetimeDays = nil
+		default:
+			days := *lifetimeDays
+			c.AdLifetimeDays = &days
+		}
+	}
+	if parentID != nil {
+		if *parentID == "" {
+			c.ParentID = nil
+		} else {
+			parent, err := s.resolveAdCategory(ctx, *parentID)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.checkCategoryCycle(ctx, c.ID, parent.ID); err != nil {
+				return nil, err
+			}
+			c.ParentID = &parent.ID
+		}
+	}
+	if err := s.repo.UpdateCategory(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkCategoryCycle проверяет, что newParentID не является самой категорией или её потомком.
+func (s *AdService) checkCategoryCycle(ctx context.Context, id, newParentID string) error {
+	all, err := s.repo.ListCategories(ctx, true)
+	if err != nil {
+		return err
+	}
+	parents := make(map[string]string, len(all))
+	for _, c := range all {
+		if c.ParentID != nil {
+			parents[c.ID] = *c.ParentID
+		}
+	}
+	// поднимаемся от нового родителя к корню; ограничение по числу шагов защищает от уже испорченных данных
+	for cur, steps := newParentID, 0; cur != "" && steps <= len(all); cur, steps = parents[cur], steps+1 {
+		if cur == id {
+			return ErrCategoryCycle
+		}
+	}
+	return nil
+}
+
+// ArchiveCategory(user_id, id) — архивирует категорию вместе с подкатегориями, только для администраторов.
+// Существующие объявления остаются в категории, но новые объявления в неё создать нельзя.
+func (s *AdService) ArchiveCategory(ctx context.Context, userID, id string) error {
+	if !s.isAdmin(userID) {
+		return ErrPermissionDenied
+	}
+	c, err := s.repo.GetCategory(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUnknownCategory
+	}
+	if err != nil {
+		return err
+	}
+	if c.ID == DefaultCategoryID {
+		return errors.New("default category cannot be archived")
+	}
+	return s.repo.ArchiveCategory(ctx, c.ID)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func strPtr(v string) *string { return &v }
+
+func testCategories() []model.Category {
+	return []model.Category{
+		{ID: DefaultCategoryID, Slug: "general", Name: "Разное"},
+		{ID: "el", Slug: "electronics", Name: "Электроника"},
+		{ID: "ph", Slug: "phones", Name: "Телефоны", ParentID: strPtr("el")},
+		{ID: "sm", Slug: "smartphones", Name: "Смартфоны", ParentID: strPtr("ph")},
+		{ID: "old", Slug: "old", Name: "Старое", Archived: true},
+	}
+}
+
+func TestCreateAd_CategoryValidation(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{categories: testCategories()}}
+	ad, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ad.CategoryID != DefaultCategoryID {
+		t.Errorf("expected default category, got %s", ad.CategoryID)
+	}
+	ad, err = svc.CreateAd(context.Background(), "u1", "T", "D", 1, "phones", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ad.CategoryID != "ph" {
+		t.Errorf("expected slug resolved to id, got %s", ad.CategoryID)
+	}
+	if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "missing", nil, nil); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+	if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "old", nil, nil); !errors.Is(err, ErrArchivedCategory) {
+		t.Errorf("expected ErrArchivedCategory, got %v", err)
+	}
+}
+
+func TestListCategories_Tree(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{categories: testCategories()}}
+	tree, err := svc.ListCategories(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tree) != 2 {
+		t.Fatalf("expected 2 root categories, got %d", len(tree))
+	}
+	el := tree[1]
+	if el.ID != "el" || len(el.Children) != 1 || len(el.Children[0].Children) != 1 || el.Children[0].Children[0].ID != "sm" {
+		t.Errorf("unexpected tree: %+v", el)
+	}
+}
+
+func TestListAds_CategoryFilter(t *testing.T) {
+	repo := &stubRepo{categories: testCategories()}
+	svc := &AdService{repo: repo}
+	if _, err := svc.ListAds(context.Background(), Filters{CategoryID: strPtr("electronics"), IncludeSubcategories: true, Limit: 10}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.CategoryID == nil || *repo.lastSearch.CategoryID != "el" || !repo.lastSearch.IncludeSubcategories {
+		t.Errorf("expected resolved category with subcategories, got %+v", repo.lastSearch)
+	}
+	if _, err := svc.ListAds(context.Background(), Filters{CategoryID: strPtr("missing"), Limit: 10}); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+}
+
+func TestCategoryAdminOnly(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{categories: testCategories()}, cfg: Config{AdminIDs: []string{"admin"}}}
+	if _, err := svc.CreateCategory(context.Background(), "u1", "cars", "Машины", "", 0); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if err := svc.ArchiveCategory(context.Background(), "u1", "el"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	c, err := svc.CreateCategory(context.Background(), "admin", "tablets", "Планшеты", "electronics", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.ParentID == nil || *c.ParentID != "el" {
+		t.Errorf("expected parent el")
+	}
+	if _, err := svc.CreateCategory(context.Background(), "admin", "Bad Slug", "X", "", 0); !errors.Is(err, ErrInvalidCategory) {
+		t.Errorf("expected ErrInvalidCategory, got %v", err)
+	}
+}
+
+func TestUpdateCategory_RejectsCycle(t *testing.T) {
+	repo := &stubRepo{categories: testCategories()}
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	if _, err := svc.UpdateCategory(context.Background(), "admin", "el", nil, nil, strPtr("sm"), nil); !errors.Is(err, ErrCategoryCycle) {
+		t.Fatalf("expected ErrCategoryCycle, got %v", err)
+	}
+	c, err := svc.UpdateCategory(context.Background(), "admin", "sm", nil, strPtr("Смартфоны и КПК"), strPtr(""), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.ParentID != nil || repo.updatedCat == nil || repo.updatedCat.Name != "Смартфоны и КПК" {
+		t.Errorf("expected category moved to root and renamed, got %+v", c)
+	}
+}
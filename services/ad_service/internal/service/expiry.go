@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// defaultAdLifetime используется, если срок жизни не задан ни категорией, ни Config.
+const defaultAdLifetime = 30 * 24 * time.Hour
+
+// maxCategoryDepth ограничивает подъём по родителям на случай испорченных данных.
+const maxCategoryDepth = 16
+
+// adLifetime возвращает срок жизни объявлений категории: ближайший заданный
+// по цепочке родителей, иначе Config.DefaultAdLifetime.
+func (s *AdService) adLifetime(ctx context.Context, categoryID string) (time.Duration, error) {
+	for id, depth := categoryID, 0; id != "" && depth < maxCategoryDepth; depth++ {
+		c, err := s.repo.GetCategory(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if c.AdLifetimeDays != nil {
+			return time.Duration(*c.AdLifetimeDays) * 24 * time.Hour, nil
+		}
+		if c.ParentID == nil {
+			break
+		}
+		id = *c.ParentID
+	}
+	if s.cfg.DefaultAdLifetime > 0 {
+		return s.cfg.DefaultAdLifetime, nil
+	}
+	return defaultAdLifetime, nil
+}
+
+// RenewAd(ad_id, user_id) продлевает объявление на срок жизни его категории.
+// Архивное или снятое с публикации объявление снова становится ACTIVE, проданное продлить нельзя.
+func (s *AdService) RenewAd(ctx context.Context, adID, userID string) (*model.Ad, error) {
+	var ad *model.Ad
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		ad, err = tx.repo.Get(ctx, adID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if ad.AuthorID != userID {
+			return ErrPermissionDenied
+		}
+		if err := checkTransition(ad.Status, model.StatusActive); err != nil {
+			return err
+		}
+		return tx.renew(ctx, ad, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ad, nil
+}
+
+// renew публикует объявление заново со свежим сроком жизни, обновляет ad и пишет событие.
+func (s *AdService) renew(ctx context.Context, ad *model.Ad, userID string) error {
+	lifetime, err := s.adLifetime(ctx, ad.CategoryID)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(lifetime)
+	if err := s.repo.RenewAd(ctx, ad.ID, ad.Status, expiresAt, userID); err != nil {
+		return err
+	}
+	from := ad.Status
+	ad.Status = model.StatusActive
+	ad.ExpiresAt = expiresAt
+	if from == model.StatusActive {
+		return s.emit(ctx, model.EventAdUpdated, ad.ID, userID, adChanges{ExpiresAt: &expiresAt})
+	}
+	return s.emit(ctx, model.EventAdStatusChanged, ad.ID, userID, statusChange{From: from, To: model.StatusActive, ExpiresAt: &expiresAt})
+}
+
+// ArchiveExpired переводит просроченные ACTIVE-объявления в ARCHIVED пачками по batch штук
+// и возвращает их число. Если проход уже выполняет другая реплика, возвращает 0.
+func (s *AdService) ArchiveExpired(ctx context.Context, batch int) (int, error) {
+	total := 0
+	for {
+		n, err := s.repo.ArchiveExpired(ctx, time.Now(), batch)
+		total += n
+		if err != nil || n < batch {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestAdLifetime_InheritsFromParent(t *testing.T) {
+	days := 7
+	parent := "el"
+	repo := &stubRepo{categories: []model.Category{
+		{ID: "el", Slug: "electronics", AdLifetimeDays: &days},
+		{ID: "ph", Slug: "phones", ParentID: &parent},
+		{ID: "gen", Slug: "general"},
+	}}
+	svc := &AdService{repo: repo, cfg: Config{DefaultAdLifetime: 48 * time.Hour}}
+
+	if got, _ := svc.adLifetime(context.Background(), "ph"); got != 7*24*time.Hour {
+		t.Errorf("expected lifetime inherited from parent, got %v", got)
+	}
+	if got, _ := svc.adLifetime(context.Background(), "gen"); got != 48*time.Hour {
+		t.Errorf("expected config default, got %v", got)
+	}
+	svc.cfg = Config{}
+	if got, _ := svc.adLifetime(context.Background(), "gen"); got != defaultAdLifetime {
+		t.Errorf("expected built-in default, got %v", got)
+	}
+}
+
+func TestCreateAd_SetsExpiry(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{}, cfg: Config{DefaultAdLifetime: time.Hour}}
+	ad, err := svc.CreateAd(context.Background(), "u1", "T", "D", 10, "", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := time.Until(ad.ExpiresAt); d <= 0 || d > time.Hour {
+		t.Errorf("unexpected expiry %v", ad.ExpiresAt)
+	}
+}
+
+func TestRenewAd(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusArchived}}
+	svc := &AdService{repo: repo}
+	ad, err := svc.RenewAd(context.Background(), "ad1", "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ad.Status != model.StatusActive || repo.renewedUntil.IsZero() || !ad.ExpiresAt.Equal(repo.renewedUntil) {
+		t.Errorf("expected archived ad to be republished, got %+v", ad)
+	}
+
+	if _, err := svc.RenewAd(context.Background(), "ad1", "u2"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for another user, got %v", err)
+	}
+	repo.getAd = &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusSold}
+	if _, err := svc.RenewAd(context.Background(), "ad1", "u1"); !errors.Is(err, ErrStatusTransition) {
+		t.Errorf("expected sold ad renewal to be rejected, got %v", err)
+	}
+}
+
+func TestGetAd_ArchivedVisibleToOwnerOnly(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusArchived}}
+	svc := &AdService{repo: repo}
+	if _, err := svc.GetAd(context.Background(), "ad1", "u1"); err != nil {
+		t.Fatalf("owner should see archived ad, got %v", err)
+	}
+	if _, err := svc.GetAd(context.Background(), "ad1", ""); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound for anonymous viewer, got %v", err)
+	}
+}
+
+func TestArchiveExpired_Batches(t *testing.T) {
+	repo := &stubRepo{expired: []int{2, 2, 1}}
+	svc := &AdService{repo: repo}
+	n, err := svc.ArchiveExpired(context.Background(), 2)
+	if err != nil || n != 5 {
+		t.Fatalf("expected 5 archived, got %d, %v", n, err)
+	}
+}
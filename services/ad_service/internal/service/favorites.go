@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// AddFavorite(user_id, ad_id)
+func (s *AdService) AddFavorite(ctx context.Context, userID, adID string) error {
+	if userID == "" || adID == "" {
+		return errors.New("user_id and ad_id are required")
+	}
+	// убеждаемся, что объявление существует, чтобы вернуть понятную ошибку вместо нарушения FK
+	if _, err := s.repo.Get(ctx, adID); errors.Is(err, pgx.ErrNoRows) {
+		return ErrAdNotFound
+	} else if err != nil {
+		return err
+	}
+	added, err := s.repo.AddFavorite(ctx, userID, adID)
+	if err != nil {
+		return err
+	}
+	if added {
+		s.stats.add(adID, model.StatFavorites, time.Now())
+	}
+	return nil
+}
+
+// RemoveFavorite(user_id, ad_id)
+func (s *AdService) RemoveFavorite(ctx context.Context, userID, adID string) error {
+	return s.repo.RemoveFavorite(ctx, userID, adID)
+}
+
+// ListFavorites(user_id, limit, offset) возвращает избранные объявления пользователя с изображениями.
+func (s *AdService) ListFavorites(ctx context.Context, userID string, limit, offset int) ([]model.Ad, int, error) {
+	ads, total, err := s.repo.ListFavorites(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.attachImages(ctx, ads); err != nil {
+		return nil, 0, err
+	}
+	for i := range ads {
+		ads[i].IsFavorite = true
+	}
+	return ads, total, nil
+}
+
+// markFavorites выставляет IsFavorite для объявлений, добавленных viewerID в избранное.
+func (s *AdService) markFavorites(ctx context.Context, viewerID string, ads []model.Ad) error {
+	if viewerID == "" || len(ads) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(ads))
+	for i := range ads {
+		ids = append(ids, ads[i].ID)
+	}
+	favs, err := s.repo.FavoriteAdIDs(ctx, viewerID, ids)
+	if err != nil {
+		return err
+	}
+	for i := range ads {
+		ads[i].IsFavorite = favs[ads[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestAddFavorite_MarksAdInGetAndList(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1"}, searchAds: []model.Ad{{ID: "ad1"}, {ID: "ad2"}}, searchCnt: 2}
+	svc := &AdService{repo: repo}
+	if err := svc.AddFavorite(context.Background(), "u1", "ad1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ad, err := svc.GetAd(context.Background(), "ad1", "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ad.IsFavorite {
+		t.Errorf("expected ad to be favorite for viewer")
+	}
+
+	page, err := svc.ListAds(context.Background(), Filters{Limit: 10, ViewerID: "u1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ads := page.Ads
+	if !ads[0].IsFavorite || ads[1].IsFavorite {
+		t.Errorf("expected only ad1 to be favorite, got %v/%v", ads[0].IsFavorite, ads[1].IsFavorite)
+	}
+}
+
+func TestListAds_NoViewerNoFavoriteFlag(t *testing.T) {
+	repo := &stubRepo{searchAds: []model.Ad{{ID: "ad1"}}, searchCnt: 1, favorites: map[string]bool{"u1/ad1": true}}
+	svc := &AdService{repo: repo}
+	page, err := svc.ListAds(context.Background(), Filters{Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Ads[0].IsFavorite {
+		t.Errorf("favorite flag must not be set without viewer")
+	}
+}
+
+func TestRemoveFavorite_NotFound(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{}}
+	if err := svc.RemoveFavorite(context.Background(), "u1", "ad1"); err == nil {
+		t.Fatalf("expected error for missing favorite")
+	}
+}
+
+func TestListFavorites_SetsFlag(t *testing.T) {
+	repo := &stubRepo{favAds: []model.Ad{{ID: "ad1"}}, favCnt: 1}
+	svc := &AdService{repo: repo}
+	ads, total, err := svc.ListFavorites(context.Background(), "u1", 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || len(ads) != 1 || !ads[0].IsFavorite {
+		t.Errorf("expected one favorite ad with flag set")
+	}
+}
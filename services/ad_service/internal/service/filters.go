@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidCondition = errors.New("condition must be one of NEW, USED, REFURBISHED")
+	ErrInvalidFilter    = errors.New("invalid listing filter")
+)
+
+// maxSellerRating — верхняя граница оценки в отзывах.
+const maxSellerRating = 5
+
+func validCondition(c string) bool {
+	switch c {
+	case model.ConditionNew, model.ConditionUsed, model.ConditionRefurbished:
+		return true
+	}
+	return false
+}
+
+// validateFilters проверяет значения фильтров ListAds до обращения к базе.
+func validateFilters(f Filters) error {
+	if f.Condition != nil && !validCondition(*f.Condition) {
+		return ErrInvalidCondition
+	}
+	if f.PriceMin != nil && *f.PriceMin < 0 || f.PriceMax != nil && *f.PriceMax < 0 {
+		return fmt.Errorf("%w: price bounds must not be negative", ErrInvalidFilter)
+	}
+	if f.PriceMin != nil && f.PriceMax != nil && *f.PriceMin > *f.PriceMax {
+		return fmt.Errorf("%w: price_min is greater than price_max", ErrInvalidFilter)
+	}
+	if f.MinSellerRating != nil && (*f.MinSellerRating < 0 || *f.MinSellerRating > maxSellerRating) {
+		return fmt.Errorf("%w: min_seller_rating must be between 0 and %d", ErrInvalidFilter, maxSellerRating)
+	}
+	if f.Near != nil {
+		return validateGeoFilter(f.Near)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestListAds_ZeroPriceBoundIsAFilter(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	zero := int64(0)
+	if _, err := svc.ListAds(context.Background(), Filters{PriceMax: &zero}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.PriceMax == nil || *repo.lastSearch.PriceMax != 0 {
+		t.Errorf("expected price_max=0 to reach the repository, got %v", repo.lastSearch.PriceMax)
+	}
+}
+
+func TestListAds_RatingAndCondition(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	rating := 4.5
+	cond := model.ConditionUsed
+	if _, err := svc.ListAds(context.Background(), Filters{MinSellerRating: &rating, Condition: &cond}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.MinSellerRating == nil || *repo.lastSearch.MinSellerRating != 4.5 || *repo.lastSearch.Condition != model.ConditionUsed {
+		t.Errorf("filters not passed to repository: %+v", repo.lastSearch)
+	}
+}
+
+func TestValidateFilters(t *testing.T) {
+	neg, one, two := int64(-1), int64(1), int64(2)
+	bad, high := "BROKEN", 5.5
+	cases := []struct {
+		name string
+		f    Filters
+		err  error
+	}{
+		{"unknown condition", Filters{Condition: &bad}, ErrInvalidCondition},
+		{"negative price", Filters{PriceMin: &neg}, ErrInvalidFilter},
+		{"inverted range", Filters{PriceMin: &two, PriceMax: &one}, ErrInvalidFilter},
+		{"rating above 5", Filters{MinSellerRating: &high}, ErrInvalidFilter},
+		{"valid range", Filters{PriceMin: &one, PriceMax: &two}, nil},
+	}
+	for _, c := range cases {
+		if err := validateFilters(c.f); !errors.Is(err, c.err) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestUpdateAd_RejectsUnknownCondition(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	cond := "like new"
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", nil, nil, nil, nil, &cond, nil, nil, nil); !errors.Is(err, ErrInvalidCondition) {
+		t.Fatalf("expected ErrInvalidCondition, got %v", err)
+	}
+	if repo.updateCalls != 0 {
+		t.Errorf("repository must not be called")
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrTooManyImages     = errors.New("too many images for one ad")
+	ErrInvalidImageOrder = errors.New("image order must list every image of the ad exactly once")
+	ErrImageNotFound     = errors.New("image not found")
+)
+
+// defaultMaxImagesPerAd используется, если Config.MaxImagesPerAd не задан.
+const defaultMaxImagesPerAd = 10
+
+func (s *AdService) maxImagesPerAd() int {
+	if s.cfg.MaxImagesPerAd > 0 {
+		return s.cfg.MaxImagesPerAd
+	}
+	return defaultMaxImagesPerAd
+}
+
+// ownImages блокирует объявление и возвращает его изображения, если userID — владелец.
+func (s *AdService) ownImages(ctx context.Context, adID, userID string) ([]model.AdImage, error) {
+	ad, err := s.repo.GetForUpdate(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAdNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ad.AuthorID != userID {
+		return nil, ErrPermissionDenied
+	}
+	return s.repo.ListImages(ctx, adID)
+}
+
+// ReorderImages(ad_id, user_id, image_ids) задаёт новый порядок изображений;
+// image_ids должен быть перестановкой текущих id. Возвращает изображения в новом порядке.
+func (s *AdService) ReorderImages(ctx context.Context, adID, userID string, imageIDs []string) ([]model.AdImage, error) {
+	var images []model.AdImage
+	err := s.inTx(ctx, func(tx *AdService) error {
+		before, err := tx.ownImages(ctx, adID, userID)
+		if err != nil {
+			return err
+		}
+		if !isPermutation(before, imageIDs) {
+			return ErrInvalidImageOrder
+		}
+		if err := tx.repo.ReorderImages(ctx, adID, imageIDs); err != nil {
+			return err
+		}
+		if images, err = tx.repo.ListImages(ctx, adID); err != nil {
+			return err
+		}
+		change, ok := diffImages(before, imageURLs(images))
+		if !ok {
+			return nil
+		}
+		if err := tx.recordRevision(ctx, adID, userID, []model.FieldChange{change}); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdImagesChanged, adID, userID, imagesChange{Order: imageIDs})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// SetPrimaryImage(ad_id, user_id, image_id) делает изображение основным, снимая отметку с прежнего.
+func (s *AdService) SetPrimaryImage(ctx context.Context, adID, userID, imageID string) ([]model.AdImage, error) {
+	var images []model.AdImage
+	err := s.inTx(ctx, func(tx *AdService) error {
+		before, err := tx.ownImages(ctx, adID, userID)
+		if err != nil {
+			return err
+		}
+		var oldURL, newURL string
+		for _, img := range before {
+			if img.IsPrimary {
+				oldURL = img.URL
+			}
+			if img.ID == imageID {
+				newURL = img.URL
+			}
+		}
+		if newURL == "" {
+			return ErrImageNotFound
+		}
+		if err := tx.repo.SetPrimaryImage(ctx, adID, imageID); errors.Is(err, pgx.ErrNoRows) {
+			return ErrImageNotFound
+		} else if err != nil {
+			return err
+		}
+		if images, err = tx.repo.ListImages(ctx, adID); err != nil {
+			return err
+		}
+		if oldURL == newURL {
+			return nil
+		}
+		change := model.FieldChange{Field: "primary_image", Old: oldURL, New: newURL}
+		if err := tx.recordRevision(ctx, adID, userID, []model.FieldChange{change}); err != nil {
+			return err
+		}
+		return tx.emit(ctx, model.EventAdImagesChanged, adID, userID, imagesChange{Primary: imageID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func isPermutation(images []model.AdImage, ids []string) bool {
+	if len(images) != len(ids) {
+		return false
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, img := range images {
+		seen[img.ID] = false
+	}
+	for _, id := range ids {
+		used, ok := seen[id]
+		if !ok || used {
+			return false
+		}
+		seen[id] = true
+	}
+	return true
+}
+
+func imageURLs(images []model.AdImage) []string {
+	urls := make([]string, 0, len(images))
+	for _, img := range images {
+		urls = append(urls, img.URL)
+	}
+	return urls
+}
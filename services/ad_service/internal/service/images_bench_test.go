@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+// roundTrip имитирует задержку одного запроса к Postgres.
+const roundTrip = 200 * time.Microsecond
+
+// latencyRepo добавляет к каждому обращению в базу задержку сети.
+type latencyRepo struct {
+	stubRepo
+}
+
+func (r *latencyRepo) Search(ctx context.Context, p repository.SearchParams) (*repository.SearchResult, error) {
+	time.Sleep(roundTrip)
+	return r.stubRepo.Search(ctx, p)
+}
+
+func (r *latencyRepo) ListImages(ctx context.Context, adID string) ([]model.AdImage, error) {
+	time.Sleep(roundTrip)
+	return r.stubRepo.ListImages(ctx, adID)
+}
+
+func (r *latencyRepo) ListImagesByAds(ctx context.Context, adIDs []string) (map[string][]model.AdImage, error) {
+	time.Sleep(roundTrip)
+	return r.stubRepo.ListImagesByAds(ctx, adIDs)
+}
+
+func benchRepo(n int) *latencyRepo {
+	ads := make([]model.Ad, n)
+	for i := range ads {
+		ads[i] = model.Ad{ID: fmt.Sprintf("ad-%d", i)}
+	}
+	return &latencyRepo{stubRepo{
+		searchAds:  ads,
+		searchCnt:  n,
+		listImages: []model.AdImage{{ID: "img", URL: "http://example/img.jpg", IsPrimary: true}},
+	}}
+}
+
+// BenchmarkListAds_BatchImages — текущий путь: один запрос за изображениями страницы.
+func BenchmarkListAds_BatchImages(b *testing.B) {
+	svc := &AdService{repo: benchRepo(50)}
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := svc.ListAds(ctx, Filters{Limit: 50}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkListAds_PerAdImages воспроизводит прежний N+1: ListImages на каждое объявление.
+func BenchmarkListAds_PerAdImages(b *testing.B) {
+	repo := benchRepo(50)
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		res, err := repo.Search(ctx, repository.SearchParams{Limit: 50})
+		if err != nil {
+			b.Fatal(err)
+		}
+		for j := range res.Ads {
+			images, err := repo.ListImages(ctx, res.Ads[j].ID)
+			if err != nil {
+				b.Fatal(err)
+			}
+			res.Ads[j].Images = images
+		}
+	}
+}
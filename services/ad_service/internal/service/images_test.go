@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func threeImages() []model.AdImage {
+	return []model.AdImage{
+		{ID: "i1", AdID: "ad1", URL: "u1", IsPrimary: true, Position: 1},
+		{ID: "i2", AdID: "ad1", URL: "u2", Position: 2},
+		{ID: "i3", AdID: "ad1", URL: "u3", Position: 3},
+	}
+}
+
+func TestReorderImages(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}, listImages: threeImages()}
+	svc := &AdService{repo: repo}
+	images, err := svc.ReorderImages(context.Background(), "ad1", "author-1", []string{"i3", "i1", "i2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(images) != 3 || images[0].ID != "i3" || images[0].Position != 1 || images[2].ID != "i2" {
+		t.Errorf("unexpected order %+v", images)
+	}
+	if len(repo.revisions) != 1 || repo.revisions[0].Changes[0].New != "u3\nu1\nu2" {
+		t.Errorf("expected images revision, got %+v", repo.revisions)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != model.EventAdImagesChanged {
+		t.Errorf("expected ad.images_changed event, got %+v", repo.events)
+	}
+}
+
+func TestReorderImages_Validation(t *testing.T) {
+	cases := map[string][]string{
+		"missing":   {"i1", "i2"},
+		"duplicate": {"i1", "i1", "i2"},
+		"unknown":   {"i1", "i2", "x"},
+	}
+	for name, ids := range cases {
+		repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}, listImages: threeImages()}
+		svc := &AdService{repo: repo}
+		if _, err := svc.ReorderImages(context.Background(), "ad1", "author-1", ids); !errors.Is(err, ErrInvalidImageOrder) {
+			t.Errorf("%s: expected ErrInvalidImageOrder, got %v", name, err)
+		}
+	}
+}
+
+func TestReorderImages_NotOwner(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "other"}, listImages: threeImages()}
+	svc := &AdService{repo: repo}
+	if _, err := svc.ReorderImages(context.Background(), "ad1", "author-1", []string{"i3", "i1", "i2"}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := (&AdService{repo: &stubRepo{}}).ReorderImages(context.Background(), "ad1", "author-1", nil); !errors.Is(err, ErrAdNotFound) {
+		t.Fatalf("expected ErrAdNotFound, got %v", err)
+	}
+}
+
+func TestSetPrimaryImage(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}, listImages: threeImages()}
+	svc := &AdService{repo: repo}
+	images, err := svc.SetPrimaryImage(context.Background(), "ad1", "author-1", "i2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if images[0].IsPrimary || !images[1].IsPrimary {
+		t.Errorf("expected i2 to be the only primary, got %+v", images)
+	}
+	if len(repo.revisions) != 1 || repo.revisions[0].Changes[0] != (model.FieldChange{Field: "primary_image", Old: "u1", New: "u2"}) {
+		t.Errorf("unexpected revision %+v", repo.revisions)
+	}
+
+	if _, err := svc.SetPrimaryImage(context.Background(), "ad1", "author-1", "nope"); !errors.Is(err, ErrImageNotFound) {
+		t.Errorf("expected ErrImageNotFound, got %v", err)
+	}
+	if _, err := svc.SetPrimaryImage(context.Background(), "ad1", "intruder", "i1"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+}
+
+func TestImageLimit(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}, listImages: threeImages()}
+	svc := &AdService{repo: repo, cfg: Config{MaxImagesPerAd: 3}}
+	if err := svc.AttachMedia(context.Background(), "ad1", "u4"); !errors.Is(err, ErrTooManyImages) {
+		t.Errorf("AttachMedia: expected ErrTooManyImages, got %v", err)
+	}
+	if repo.attachCalls != 0 {
+		t.Errorf("nothing should be attached over the limit")
+	}
+	if err := svc.ReplaceImages(context.Background(), "ad1", "author-1", []string{"a", "b", "c", "d"}); !errors.Is(err, ErrTooManyImages) {
+		t.Errorf("ReplaceImages: expected ErrTooManyImages, got %v", err)
+	}
+	if err := svc.ReplaceImages(context.Background(), "ad1", "author-1", []string{"a", "", "b", "c"}); err != nil {
+		t.Errorf("empty media ids should not count towards the limit: %v", err)
+	}
+	if _, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, "", nil, nil, []string{"a", "b", "c", "d"}); !errors.Is(err, ErrTooManyImages) {
+		t.Errorf("CreateAdWithImages: expected ErrTooManyImages, got %v", err)
+	}
+}
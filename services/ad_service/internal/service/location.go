@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"78-pflops/services/ad_service/internal/geo"
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrInvalidLocation — город или регион не из справочника, либо координаты не согласуются с ним.
+var ErrInvalidLocation = errors.New("invalid location")
+
+const (
+	// maxCityDistanceKm — насколько точка объявления может отстоять от центра указанного города.
+	maxCityDistanceKm = 100
+	// maxSearchRadiusKm ограничивает радиус фильтра near.
+	maxSearchRadiusKm = 500
+)
+
+// normalizeLocation проверяет местоположение по справочнику и приводит названия к каноническим.
+// Регион города подставляется сам; без координат объявление с городом получает координаты
+// его центра и находится поиском по радиусу.
+func normalizeLocation(loc *model.Location) error {
+	if loc.IsZero() {
+		return nil
+	}
+	if (loc.Lat == nil) != (loc.Lon == nil) {
+		return fmt.Errorf("%w: lat and lon must be set together", ErrInvalidLocation)
+	}
+	if loc.Lat != nil && !geo.ValidPoint(*loc.Lat, *loc.Lon) {
+		return fmt.Errorf("%w: coordinates out of range", ErrInvalidLocation)
+	}
+	if loc.Region != "" {
+		region, ok := geo.LookupRegion(loc.Region)
+		if !ok {
+			return fmt.Errorf("%w: unknown region %q", ErrInvalidLocation, loc.Region)
+		}
+		loc.Region = region
+	}
+	if loc.City == "" {
+		return nil
+	}
+	city, ok := geo.LookupCity(loc.City)
+	if !ok {
+		return fmt.Errorf("%w: unknown city %q", ErrInvalidLocation, loc.City)
+	}
+	if loc.Region != "" && loc.Region != city.Region {
+		return fmt.Errorf("%w: %s is not in %s", ErrInvalidLocation, city.Name, loc.Region)
+	}
+	loc.City, loc.Region = city.Name, city.Region
+	if loc.Lat == nil {
+		lat, lon := city.Lat, city.Lon
+		loc.Lat, loc.Lon = &lat, &lon
+	} else if geo.DistanceKm(city.Lat, city.Lon, *loc.Lat, *loc.Lon) > maxCityDistanceKm {
+		return fmt.Errorf("%w: coordinates are more than %d km from %s", ErrInvalidLocation, maxCityDistanceKm, city.Name)
+	}
+	return nil
+}
+
+// validateGeoFilter проверяет точку и радиус фильтра near.
+func validateGeoFilter(g *model.GeoFilter) error {
+	if !geo.ValidPoint(g.Lat, g.Lon) {
+		return fmt.Errorf("%w: near coordinates out of range", ErrInvalidFilter)
+	}
+	if g.RadiusKm <= 0 || g.RadiusKm > maxSearchRadiusKm {
+		return fmt.Errorf("%w: radius_km must be in (0, %d]", ErrInvalidFilter, maxSearchRadiusKm)
+	}
+	return nil
+}
+
+// locationString — местоположение одной строкой для истории правок.
+func locationString(loc model.Location) string {
+	var parts []string
+	for _, p := range []string{loc.City, loc.Region} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	s := strings.Join(parts, ", ")
+	if loc.Lat != nil {
+		s += fmt.Sprintf(" (%.6f, %.6f)", *loc.Lat, *loc.Lon)
+	}
+	return strings.TrimSpace(s)
+}
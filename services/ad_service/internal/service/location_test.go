@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+func TestCreateAd_NormalizesLocation(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{}}
+	ad, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", &model.Location{City: " казань"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loc := ad.Location
+	if loc.City != "Казань" || loc.Region != "Республика Татарстан" {
+		t.Errorf("expected canonical city and its region, got %+v", loc)
+	}
+	// без координат объявление получает центр города
+	if loc.Lat == nil || loc.Lon == nil || *loc.Lat != 55.7961 || *loc.Lon != 49.1064 {
+		t.Errorf("expected city center coordinates, got %v, %v", loc.Lat, loc.Lon)
+	}
+}
+
+func TestCreateAd_RejectsInvalidLocation(t *testing.T) {
+	lat, lon, farLon := 55.79, 49.12, 37.62
+	cases := map[string]model.Location{
+		"unknown city":       {City: "Атлантида"},
+		"unknown region":     {Region: "Гиперборея"},
+		"city not in region": {City: "Казань", Region: "Московская область"},
+		"lat without lon":    {City: "Казань", Lat: &lat},
+		"far from city":      {City: "Казань", Lat: &lat, Lon: &farLon},
+	}
+	for name, loc := range cases {
+		svc := &AdService{repo: &stubRepo{}}
+		if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", &loc, nil); !errors.Is(err, ErrInvalidLocation) {
+			t.Errorf("%s: expected ErrInvalidLocation, got %v", name, err)
+		}
+	}
+	// регион без города и координаты рядом с городом допустимы
+	for _, loc := range []model.Location{{Region: "московская область"}, {City: "Казань", Lat: &lat, Lon: &lon}} {
+		svc := &AdService{repo: &stubRepo{}}
+		if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", &loc, nil); err != nil {
+			t.Errorf("%+v: unexpected error: %v", loc, err)
+		}
+	}
+}
+
+func TestUpdateAd_ReplacesLocationAndRecordsRevision(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour), Location: model.Location{Region: "Московская область"}}}
+	svc := &AdService{repo: repo}
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", nil, nil, nil, nil, nil, nil, &model.Location{City: "Химки"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.updatedLoc == nil || repo.updatedLoc.City != "Химки" || repo.updatedLoc.Region != "Московская область" {
+		t.Errorf("expected normalized location in repository, got %+v", repo.updatedLoc)
+	}
+	if len(repo.revisions) != 1 || repo.revisions[0].Changes[0].Field != "location" || repo.revisions[0].Changes[0].Old != "Московская область" {
+		t.Errorf("expected location revision, got %+v", repo.revisions)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != model.EventAdUpdated {
+		t.Errorf("expected ad.updated event, got %+v", repo.events)
+	}
+}
+
+func TestListAds_GeoFilters(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	city := "королев"
+	near := &model.GeoFilter{Lat: 55.75, Lon: 37.62, RadiusKm: 30}
+	if _, err := svc.ListAds(context.Background(), Filters{City: &city, Near: near, Sort: repository.SortDistance}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastSearch.City == nil || *repo.lastSearch.City != "Королёв" || repo.lastSearch.Region != nil || repo.lastSearch.Near != near {
+		t.Errorf("geo filters not passed to repository: %+v", repo.lastSearch)
+	}
+
+	unknown := "Атлантида"
+	if _, err := svc.ListAds(context.Background(), Filters{City: &unknown}); !errors.Is(err, ErrInvalidLocation) {
+		t.Errorf("expected ErrInvalidLocation for unknown city, got %v", err)
+	}
+	if _, err := svc.ListAds(context.Background(), Filters{Sort: repository.SortDistance}); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("expected ErrInvalidFilter for distance sort without near, got %v", err)
+	}
+	for _, g := range []model.GeoFilter{{Lat: 55, Lon: 37, RadiusKm: 0}, {Lat: 55, Lon: 37, RadiusKm: 501}, {Lat: 91, Lon: 37, RadiusKm: 10}} {
+		if _, err := svc.ListAds(context.Background(), Filters{Near: &g}); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%+v: expected ErrInvalidFilter, got %v", g, err)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidReport = errors.New("invalid report")
+	// ErrReportNotFound — жалобы с таким id нет.
+	ErrReportNotFound = errors.New("report not found")
+	// ErrReportResolved — жалоба уже рассмотрена.
+	ErrReportResolved = errors.New("report already resolved")
+	// ErrAdNotHidden — UnhideAd для объявления, которое не скрыто.
+	ErrAdNotHidden = errors.New("ad is not hidden")
+)
+
+const (
+	// defaultReportHideThreshold — после жалоб стольких разных пользователей объявление скрывается до решения модератора.
+	defaultReportHideThreshold = 3
+	maxReportComment           = 1000
+)
+
+var reportReasons = []string{model.ReasonScam, model.ReasonProhibited, model.ReasonSpam, model.ReasonOffensive, model.ReasonOther}
+
+func validReportReason(reason string) bool {
+	for _, r := range reportReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func validReportStatus(status string) bool {
+	return status == model.ReportOpen || status == model.ReportInReview || status == model.ReportResolved
+}
+
+func (s *AdService) reportHideThreshold() int {
+	if s.cfg.ReportHideThreshold > 0 {
+		return s.cfg.ReportHideThreshold
+	}
+	return defaultReportHideThreshold
+}
+
+// hiddenFrom сообщает, что viewerID не должен видеть объявление: оно снято с публикации
+// или скрыто модерацией, а зритель не владелец и не администратор.
+func (s *AdService) hiddenFrom(ad *model.Ad, viewerID string) bool {
+	if !ownerOnly(ad.Status) && ad.HiddenAt == nil {
+		return false
+	}
+	return ad.AuthorID != viewerID && !s.isAdmin(viewerID)
+}
+
+// audit пишет запись в журнал модерации.
+func (s *AdService) audit(ctx context.Context, adID string, reportID *string, actorID, action, comment string) error {
+	return s.repo.InsertModerationAction(ctx, &model.ModerationAction{AdID: adID, ReportID: reportID, ActorID: actorID, Action: action, Comment: comment})
+}
+
+// ReportAd(ad_id, user_id, reason, comment) ставит жалобу в очередь модерации. Жаловаться можно
+// на видимое и не скрытое объявление, не на своё и не дважды, пока первая жалоба не рассмотрена.
+// Когда жалобы на объявление подали Config.ReportHideThreshold разных пользователей, оно скрывается
+// из выдачи, а его жалобы переходят в IN_REVIEW.
+func (s *AdService) ReportAd(ctx context.Context, adID, userID, reason, comment string) (*model.Report, error) {
+	comment = strings.TrimSpace(comment)
+	if !validReportReason(reason) {
+		return nil, fmt.Errorf("%w: reason must be one of %s", ErrInvalidReport, strings.Join(reportReasons, ", "))
+	}
+	if reason == model.ReasonOther && comment == "" {
+		return nil, fmt.Errorf("%w: comment is required for reason other", ErrInvalidReport)
+	}
+	if utf8.RuneCountInString(comment) > maxReportComment {
+		return nil, fmt.Errorf("%w: comment is longer than %d characters", ErrInvalidReport, maxReportComment)
+	}
+	report := &model.Report{AdID: adID, ReporterID: userID, Reason: reason, Comment: comment, Status: model.ReportOpen}
+	err := s.inTx(ctx, func(tx *AdService) error {
+		// блокировка объявления упорядочивает параллельные жалобы: порог проверяется один раз
+		ad, err := tx.repo.GetForUpdate(ctx, adID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		}
+		if err != nil {
+			return err
+		}
+		// скрытое объявление уже у модераторов, новые жалобы на него не принимаются
+		if ad.HiddenAt != nil || tx.hiddenFrom(ad, userID) {
+			return ErrAdNotFound
+		}
+		if ad.AuthorID == userID {
+			return fmt.Errorf("%w: cannot report own ad", ErrInvalidReport)
+		}
+		if err := tx.repo.CreateReport(ctx, report); err != nil {
+			return err
+		}
+		if err := tx.audit(ctx, adID, &report.ID, userID, model.ActionReport, reason); err != nil {
+			return err
+		}
+		n, err := tx.repo.CountPendingReporters(ctx, adID)
+		if err != nil || n < tx.reportHideThreshold() {
+			return err
+		}
+		return tx.autoHide(ctx, adID, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// autoHide скрывает объявление по порогу жалоб до решения модератора.
+func (s *AdService) autoHide(ctx context.Context, adID string, reporters int) error {
+	if err := s.repo.SetAdHidden(ctx, adID, true); err != nil {
+		return err
+	}
+	if _, err := s.repo.UpdateAdReports(ctx, adID, []string{model.ReportOpen}, model.ReportInReview, "", ""); err != nil {
+		return err
+	}
+	if err := s.audit(ctx, adID, nil, "", model.ActionAutoHide, fmt.Sprintf("жалоб от %d пользователей", reporters)); err != nil {
+		return err
+	}
+	return s.emit(ctx, model.EventAdHidden, adID, "", hidden{Reason: "reports", Reporters: reporters})
+}
+
+// ListReports(user_id, status?, ad_id?) — очередь жалоб для администраторов, старые первыми.
+func (s *AdService) ListReports(ctx context.Context, userID, status, adID string, limit, offset int) ([]model.Report, int, error) {
+	if !s.isAdmin(userID) {
+		return nil, 0, ErrPermissionDenied
+	}
+	if status != "" && !validReportStatus(status) {
+		return nil, 0, fmt.Errorf("%w: unknown status %q", ErrInvalidReport, status)
+	}
+	return s.repo.ListReports(ctx, status, adID, limit, offset)
+}
+
+// HideAd(user_id, ad_id, comment) — решение модератора скрыть объявление; все нерассмотренные
+// жалобы на него закрываются. Подходит и для подтверждения автоматического скрытия.
+func (s *AdService) HideAd(ctx context.Context, userID, adID, comment string) error {
+	return s.moderateAd(ctx, userID, adID, comment, true)
+}
+
+// UnhideAd(user_id, ad_id, comment) возвращает скрытое объявление в выдачу; нерассмотренные
+// жалобы на него закрываются как необоснованные.
+func (s *AdService) UnhideAd(ctx context.Context, userID, adID, comment string) error {
+	return s.moderateAd(ctx, userID, adID, comment, false)
+}
+
+func (s *AdService) moderateAd(ctx context.Context, userID, adID, comment string, hide bool) error {
+	if !s.isAdmin(userID) {
+		return ErrPermissionDenied
+	}
+	comment = strings.TrimSpace(comment)
+	return s.inTx(ctx, func(tx *AdService) error {
+		ad, err := tx.repo.GetForUpdate(ctx, adID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		}
+		if err != nil {
+			return err
+		}
+		wasHidden := ad.HiddenAt != nil
+		if !hide && !wasHidden {
+			return ErrAdNotHidden
+		}
+		if hide != wasHidden {
+			if err := tx.repo.SetAdHidden(ctx, adID, hide); err != nil {
+				return err
+			}
+		}
+		action, resolution := model.ActionHide, model.ResolutionHidden
+		if !hide {
+			action, resolution = model.ActionUnhide, model.ResolutionRestored
+		}
+		pending := []string{model.ReportOpen, model.ReportInReview}
+		if _, err := tx.repo.UpdateAdReports(ctx, adID, pending, model.ReportResolved, resolution, userID); err != nil {
+			return err
+		}
+		if err := tx.audit(ctx, adID, nil, userID, action, comment); err != nil {
+			return err
+		}
+		switch {
+		case hide && !wasHidden:
+			return tx.emit(ctx, model.EventAdHidden, adID, userID, hidden{Reason: "moderator"})
+		case !hide:
+			return tx.emit(ctx, model.EventAdUnhidden, adID, userID, struct{}{})
+		}
+		return nil
+	})
+}
+
+// RejectReport(user_id, report_id, comment) отклоняет одну жалобу. Скрытое объявление
+// остаётся скрытым: вернуть его в выдачу может только UnhideAd.
+func (s *AdService) RejectReport(ctx context.Context, userID, reportID, comment string) (*model.Report, error) {
+	if !s.isAdmin(userID) {
+		return nil, ErrPermissionDenied
+	}
+	var report *model.Report
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		report, err = tx.repo.GetReportForUpdate(ctx, reportID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrReportNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if report.Status == model.ReportResolved {
+			return ErrReportResolved
+		}
+		if err := tx.repo.ResolveReport(ctx, reportID, model.ResolutionRejected, userID); err != nil {
+			return err
+		}
+		report.Status, report.Resolution, report.ReviewerID = model.ReportResolved, model.ResolutionRejected, userID
+		return tx.audit(ctx, report.AdID, &report.ID, userID, model.ActionReject, strings.TrimSpace(comment))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ListModerationLog(user_id, ad_id) — журнал модерации объявления для администраторов, новые записи первыми.
+func (s *AdService) ListModerationLog(ctx context.Context, userID, adID string, limit, offset int) ([]model.ModerationAction, int, error) {
+	if !s.isAdmin(userID) {
+		return nil, 0, ErrPermissionDenied
+	}
+	return s.repo.ListModerationActions(ctx, adID, limit, offset)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+func moderationService(repo *stubRepo) *AdService {
+	return &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}, ReportHideThreshold: 2}}
+}
+
+func TestReportAd_Validation(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd()}
+	svc := moderationService(repo)
+	cases := map[string]struct {
+		user, reason, comment string
+		want                  error
+	}{
+		"unknown reason":    {"u2", "ugly", "", ErrInvalidReport},
+		"other w/o comment": {"u2", model.ReasonOther, " ", ErrInvalidReport},
+		"own ad":            {"u1", model.ReasonSpam, "", ErrInvalidReport},
+	}
+	for name, c := range cases {
+		if _, err := svc.ReportAd(context.Background(), "ad1", c.user, c.reason, c.comment); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", name, c.want, err)
+		}
+	}
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u2", model.ReasonScam, "просит предоплату"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u2", model.ReasonSpam, ""); !errors.Is(err, repository.ErrDuplicateReport) {
+		t.Errorf("expected ErrDuplicateReport, got %v", err)
+	}
+	// неопубликованное объявление посторонним не видно
+	repo.getAd.Status = model.StatusInactive
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u3", model.ReasonSpam, ""); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound, got %v", err)
+	}
+}
+
+func TestReportAd_AutoHidesAfterDistinctReporters(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd()}
+	svc := moderationService(repo)
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u2", model.ReasonScam, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.getAd.HiddenAt != nil {
+		t.Fatal("one report must not hide the ad")
+	}
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u3", model.ReasonScam, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.getAd.HiddenAt == nil {
+		t.Fatal("expected the ad to be hidden")
+	}
+	for _, r := range repo.reports {
+		if r.Status != model.ReportInReview {
+			t.Errorf("expected report in review, got %+v", r)
+		}
+	}
+	last := repo.audit[len(repo.audit)-1]
+	if len(repo.audit) != 3 || last.Action != model.ActionAutoHide || last.ActorID != "" {
+		t.Errorf("expected two report records and auto_hide, got %+v", repo.audit)
+	}
+	if ev := repo.events[len(repo.events)-1]; ev.Type != model.EventAdHidden {
+		t.Errorf("expected ad.hidden event, got %+v", ev)
+	}
+
+	// скрытое объявление не видно посторонним, владелец его видит
+	if _, err := svc.GetAd(context.Background(), "ad1", "u2"); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound for a stranger, got %v", err)
+	}
+	if _, err := svc.GetAd(context.Background(), "ad1", "u1"); err != nil {
+		t.Errorf("owner must see the hidden ad, got %v", err)
+	}
+	if _, err := svc.ReportAd(context.Background(), "ad1", "u4", model.ReasonScam, ""); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound for a report on a hidden ad, got %v", err)
+	}
+}
+
+func TestModerateAd(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd()}
+	svc := moderationService(repo)
+	for _, u := range []string{"u2", "u3"} {
+		if _, err := svc.ReportAd(context.Background(), "ad1", u, model.ReasonSpam, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := svc.UnhideAd(context.Background(), "u1", "ad1", ""); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if err := svc.UnhideAd(context.Background(), "admin", "ad1", "ложная тревога"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.getAd.HiddenAt != nil {
+		t.Error("expected the ad to be visible again")
+	}
+	for _, r := range repo.reports {
+		if r.Status != model.ReportResolved || r.Resolution != model.ResolutionRestored || r.ReviewerID != "admin" {
+			t.Errorf("expected resolved report, got %+v", r)
+		}
+	}
+	if err := svc.UnhideAd(context.Background(), "admin", "ad1", ""); !errors.Is(err, ErrAdNotHidden) {
+		t.Errorf("expected ErrAdNotHidden, got %v", err)
+	}
+
+	// после рассмотрения тот же пользователь может пожаловаться снова
+	report, err := svc.ReportAd(context.Background(), "ad1", "u2", model.ReasonScam, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.HideAd(context.Background(), "admin", "ad1", "мошенник"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.getAd.HiddenAt == nil || repo.reports[len(repo.reports)-1].Resolution != model.ResolutionHidden {
+		t.Errorf("expected hidden ad and resolved report, got %+v", repo.reports)
+	}
+	if _, err := svc.RejectReport(context.Background(), "admin", report.ID, ""); !errors.Is(err, ErrReportResolved) {
+		t.Errorf("expected ErrReportResolved, got %v", err)
+	}
+	last := repo.audit[len(repo.audit)-1]
+	if last.Action != model.ActionHide || last.ActorID != "admin" || last.Comment != "мошенник" {
+		t.Errorf("unexpected audit record %+v", last)
+	}
+}
+
+func TestRejectReport(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd()}
+	svc := moderationService(repo)
+	report, err := svc.ReportAd(context.Background(), "ad1", "u2", model.ReasonOffensive, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.RejectReport(context.Background(), "u2", report.ID, ""); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := svc.RejectReport(context.Background(), "admin", "missing", ""); !errors.Is(err, ErrReportNotFound) {
+		t.Fatalf("expected ErrReportNotFound, got %v", err)
+	}
+	got, err := svc.RejectReport(context.Background(), "admin", report.ID, "нарушений нет")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != model.ReportResolved || got.Resolution != model.ResolutionRejected {
+		t.Errorf("unexpected report %+v", got)
+	}
+	open, _, err := svc.ListReports(context.Background(), "admin", model.ReportOpen, "", 20, 0)
+	if err != nil || len(open) != 0 {
+		t.Errorf("expected empty queue, got %v, %v", open, err)
+	}
+	log, total, err := svc.ListModerationLog(context.Background(), "admin", "ad1", 20, 0)
+	if err != nil || total != 2 || log[1].Action != model.ActionReject {
+		t.Errorf("expected report and reject records, got %+v, %v", log, err)
+	}
+	if _, _, err := svc.ListReports(context.Background(), "admin", "DONE", "", 20, 0); !errors.Is(err, ErrInvalidReport) {
+		t.Errorf("expected ErrInvalidReport for unknown status, got %v", err)
+	}
+}
+
+func TestHiddenAdCannotBePromoted(t *testing.T) {
+	ad := activeAd()
+	now := time.Now()
+	ad.HiddenAt = &now
+	svc := moderationService(&stubRepo{getAd: ad, balance: 1000})
+	if _, _, err := svc.PromoteAd(context.Background(), "ad1", "u1", 1); !errors.Is(err, ErrAdNotActive) {
+		t.Errorf("expected ErrAdNotActive, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// Publisher доставляет события из outbox потребителям (брокер, лог, файл).
+// Доставка at-least-once: после сбоя событие может прийти повторно,
+// потребители отбрасывают дубли по AdEvent.ID.
+type Publisher interface {
+	Publish(ctx context.Context, ev model.AdEvent) error
+}
+
+// emit пишет событие в outbox через текущий репозиторий; внутри inTx
+// оно фиксируется или откатывается вместе с самим изменением.
+func (s *AdService) emit(ctx context.Context, eventType, adID, actorID string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return s.repo.InsertEvent(ctx, &model.AdEvent{Type: eventType, AdID: adID, ActorID: actorID, Payload: data})
+}
+
+// RelayEvents публикует до batch неотправленных событий в порядке seq и возвращает
+// число доставленных. seq выдаётся до коммита, поэтому отправляются только события не новее
+// SettledEventSeq: после него раньше уже ничего не закоммитится. Одновременно работает
+// релей одной реплики. На первой ошибке публикации останавливается, чтобы не нарушать порядок:
+// доставленные до неё события отмечаются, остальные уйдут в следующем проходе.
+func (s *AdService) RelayEvents(ctx context.Context, pub Publisher, batch int) (int, error) {
+	upTo, err := s.repo.SettledEventSeq(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var sent []string
+	var pubErr error
+	err = s.inTx(ctx, func(tx *AdService) error {
+		events, err := tx.repo.LockPendingEvents(ctx, upTo, batch)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if pubErr = pub.Publish(ctx, ev); pubErr != nil {
+				break
+			}
+			sent = append(sent, ev.ID)
+		}
+		return tx.repo.MarkEventsPublished(ctx, sent)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(sent), pubErr
+}
+
+// adSnapshot — полезная нагрузка ad.created.
+type adSnapshot struct {
+	AuthorID    string            `json:"author_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Price       int64             `json:"price"`
+	CategoryID  string            `json:"category_id"`
+	Condition   string            `json:"condition"`
+	Status      string            `json:"status"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+	ImageURLs   []string          `json:"image_urls,omitempty"`
+	Location    *model.Location   `json:"location,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+}
+
+func snapshotOf(ad *model.Ad) adSnapshot {
+	snap := adSnapshot{
+		AuthorID:    ad.AuthorID,
+		Title:       ad.Title,
+		Description: ad.Description,
+		Price:       ad.Price,
+		CategoryID:  ad.CategoryID,
+		Condition:   ad.Condition,
+		Status:      ad.Status,
+		ExpiresAt:   ad.ExpiresAt,
+	}
+	if !ad.Location.IsZero() {
+		snap.Location = &ad.Location
+	}
+	snap.Attributes = ad.Attributes
+	for _, img := range ad.Images {
+		snap.ImageURLs = append(snap.ImageURLs, img.URL)
+	}
+	return snap
+}
+
+// adChanges — полезная нагрузка ad.updated: только изменённые поля с новыми значениями.
+type adChanges struct {
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Price       *int64     `json:"price,omitempty"`
+	CategoryID  *string    `json:"category_id,omitempty"`
+	Condition   *string    `json:"condition,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	// Location — новое местоположение целиком; пустой объект — местоположение сброшено.
+	Location *model.Location `json:"location,omitempty"`
+	// Attributes — все характеристики после изменения (указатель: карта несравнима, а {} — значимое значение).
+	Attributes *map[string]string `json:"attributes,omitempty"`
+}
+
+func (c adChanges) empty() bool {
+	return c == adChanges{}
+}
+
+// statusChange — полезная нагрузка ad.status_changed.
+type statusChange struct {
+	From      string     `json:"from"`
+	To        string     `json:"to"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // при повторной публикации
+}
+
+// promotion — полезная нагрузка ad.promoted.
+type promotion struct {
+	Days  int       `json:"days"`
+	Cost  int64     `json:"cost"`
+	Until time.Time `json:"until"`
+}
+
+// bump — полезная нагрузка ad.bumped.
+type bump struct {
+	BumpedAt time.Time `json:"bumped_at"`
+}
+
+// hidden — полезная нагрузка ad.hidden: по порогу жалоб (reports) или решением модератора (moderator).
+type hidden struct {
+	Reason    string `json:"reason"`
+	Reporters int    `json:"reporters,omitempty"`
+}
+
+// imagesChange — полезная нагрузка ad.images_changed после AttachMedia/DetachMedia,
+// ReorderImages (новый порядок id) и SetPrimaryImage (id основного изображения).
+type imagesChange struct {
+	Attached string   `json:"attached,omitempty"`
+	Detached string   `json:"detached,omitempty"`
+	Order    []string `json:"order,omitempty"`
+	Primary  string   `json:"primary,omitempty"`
+}
+
+// imagesReplaced — полезная нагрузка ad.images_changed после ReplaceImages: новый полный список.
+type imagesReplaced struct {
+	MediaIDs []string `json:"media_ids"`
+}
+
+// nonEmpty отбрасывает пустые media id, как это делает ReplaceImages в репозитории.
+func nonEmpty(ids []string) []string {
+	res := []string{}
+	for _, id := range ids {
+		if id != "" {
+			res = append(res, id)
+		}
+	}
+	return res
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func eventTypes(events []model.AdEvent) []string {
+	var types []string
+	for _, ev := range events {
+		types = append(types, ev.Type)
+	}
+	return types
+}
+
+func TestCreateAd_WritesCreatedEvent(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	ad, err := svc.CreateAd(context.Background(), "author-1", "Bike", "D", 100, "", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.events) != 1 {
+		t.Fatalf("expected one event, got %v", eventTypes(repo.events))
+	}
+	ev := repo.events[0]
+	if ev.Type != model.EventAdCreated || ev.AdID != ad.ID || ev.ActorID != "author-1" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+	var snap adSnapshot
+	if err := json.Unmarshal(ev.Payload, &snap); err != nil || snap.Title != "Bike" || snap.Price != 100 {
+		t.Errorf("unexpected payload %s (%v)", ev.Payload, err)
+	}
+}
+
+func TestCreateAdWithImages_FailureWritesNoEvent(t *testing.T) {
+	repo := &stubRepo{attachErr: context.Canceled, attachFailOn: 2}
+	svc := &AdService{repo: repo}
+	if _, err := svc.CreateAdWithImages(context.Background(), "author-1", "T", "D", 10, "", nil, nil, []string{"m1", "m2"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(repo.events) != 0 {
+		t.Errorf("rolled back operation must not leave events, got %v", eventTypes(repo.events))
+	}
+}
+
+func TestUpdateAd_WritesUpdatedAndStatusEvents(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1", Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour)}}
+	svc := &AdService{repo: repo}
+	price := int64(50)
+	sold := model.StatusSold
+	if err := svc.UpdateAd(context.Background(), "ad1", "author-1", nil, nil, &price, nil, nil, &sold, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	types := eventTypes(repo.events)
+	if len(types) != 2 || types[0] != model.EventAdUpdated || types[1] != model.EventAdStatusChanged {
+		t.Fatalf("unexpected events %v", types)
+	}
+	if string(repo.events[0].Payload) != `{"price":50}` {
+		t.Errorf("updated payload should list only changed fields, got %s", repo.events[0].Payload)
+	}
+	if string(repo.events[1].Payload) != `{"from":"ACTIVE","to":"SOLD"}` {
+		t.Errorf("unexpected status payload %s", repo.events[1].Payload)
+	}
+}
+
+func TestDeleteAndImageChanges_WriteEvents(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "author-1", Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour)}}
+	svc := &AdService{repo: repo}
+	ctx := context.Background()
+	if err := svc.ReplaceImages(ctx, "ad1", "author-1", []string{"m1", "", "m2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DetachMedia(ctx, "ad1", "m1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DeleteAd(ctx, "ad1", "author-1"); err != nil {
+		t.Fatal(err)
+	}
+	types := eventTypes(repo.events)
+	if len(types) != 3 || types[0] != model.EventAdImagesChanged || types[1] != model.EventAdImagesChanged || types[2] != model.EventAdDeleted {
+		t.Fatalf("unexpected events %v", types)
+	}
+	if string(repo.events[0].Payload) != `{"media_ids":["m1","m2"]}` {
+		t.Errorf("unexpected replace payload %s", repo.events[0].Payload)
+	}
+
+	failing := &stubRepo{deleteErr: errors.New("not found or no permission")}
+	if err := (&AdService{repo: failing}).DeleteAd(ctx, "ad1", "other"); err == nil || len(failing.events) != 0 {
+		t.Errorf("failed delete must not write an event")
+	}
+}
+
+// flakyPublisher принимает first событий, затем отказывает.
+type flakyPublisher struct {
+	first int
+	got   []string
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, ev model.AdEvent) error {
+	if len(p.got) >= p.first {
+		return errors.New("broker unavailable")
+	}
+	p.got = append(p.got, ev.ID)
+	return nil
+}
+
+func TestRelayEvents_AtLeastOnceInOrder(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := svc.CreateAd(ctx, "author-1", "T", "D", 1, "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pub := &flakyPublisher{first: 1}
+	n, err := svc.RelayEvents(ctx, pub, 10)
+	if err == nil || n != 1 {
+		t.Fatalf("expected one delivered event and an error, got %d, %v", n, err)
+	}
+	if len(repo.published) != 1 || repo.published[0] != "ev1" {
+		t.Errorf("only delivered events are marked, got %v", repo.published)
+	}
+
+	pub.first = 10
+	n, err = svc.RelayEvents(ctx, pub, 10)
+	if err != nil || n != 2 {
+		t.Fatalf("expected the remaining 2 events, got %d, %v", n, err)
+	}
+	if len(pub.got) != 3 || pub.got[1] != "ev2" || pub.got[2] != "ev3" {
+		t.Errorf("events must be delivered in write order, got %v", pub.got)
+	}
+	if n, _ := svc.RelayEvents(ctx, pub, 10); n != 0 {
+		t.Errorf("nothing left to relay, got %d", n)
+	}
+}
+
+func TestRelayEvents_WaitsForUnsettledSeq(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := svc.CreateAd(ctx, "author-1", "T", "D", 1, "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// транзакция второго события ещё не завершилась
+	repo.unsettled = 1
+	pub := &flakyPublisher{first: 10}
+	if n, err := svc.RelayEvents(ctx, pub, 10); err != nil || n != 1 {
+		t.Fatalf("expected only the settled event, got %d, %v", n, err)
+	}
+
+	repo.unsettled = 0
+	if n, err := svc.RelayEvents(ctx, pub, 10); err != nil || n != 1 {
+		t.Fatalf("expected the second event once settled, got %d, %v", n, err)
+	}
+	if len(pub.got) != 2 || pub.got[0] != "ev1" || pub.got[1] != "ev2" {
+		t.Errorf("events must be delivered in seq order, got %v", pub.got)
+	}
+}
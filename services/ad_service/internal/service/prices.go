@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// recordPriceChange пишет новую цену в историю, а при снижении — уведомления
+// всем, у кого объявление в избранном.
+func (s *AdService) recordPriceChange(ctx context.Context, adID, actorID string, oldPrice, newPrice int64) error {
+	if err := s.repo.InsertPricePoint(ctx, adID, newPrice, actorID); err != nil {
+		return err
+	}
+	if newPrice >= oldPrice {
+		return nil
+	}
+	_, err := s.repo.NotifyPriceDrop(ctx, adID, oldPrice, newPrice)
+	return err
+}
+
+// GetPriceHistory(ad_id, viewer_id?) возвращает цены объявления от первой к последней.
+// Видимость та же, что у GetAd.
+func (s *AdService) GetPriceHistory(ctx context.Context, adID, viewerID string) ([]model.PricePoint, error) {
+	ad, err := s.repo.Get(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAdNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.hiddenFrom(ad, viewerID) {
+		return nil, ErrAdNotFound
+	}
+	return s.repo.ListPriceHistory(ctx, adID)
+}
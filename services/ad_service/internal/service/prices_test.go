@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestCreateAd_RecordsInitialPrice(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	if _, err := svc.CreateAd(context.Background(), "u1", "T", "D", 500, "", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.prices) != 1 || repo.prices[0] != 500 {
+		t.Errorf("expected initial price in history, got %v", repo.prices)
+	}
+}
+
+func TestUpdateAd_PriceHistoryAndDropNotifications(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Price: 100, Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour)}}
+	svc := &AdService{repo: repo}
+	for _, p := range []int64{100, 120, 90} {
+		price := p
+		if err := svc.UpdateAd(context.Background(), "ad1", "u1", nil, nil, &price, nil, nil, nil, nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// 100 → 100 ничего не меняет; цена сравнивается с getAd (100), поэтому снижение — только 90
+	if len(repo.prices) != 2 || repo.prices[0] != 120 || repo.prices[1] != 90 {
+		t.Errorf("expected price changes in history, got %v", repo.prices)
+	}
+	if len(repo.priceDrops) != 1 || repo.priceDrops[0] != 90 {
+		t.Errorf("expected one price-drop notification, got %v", repo.priceDrops)
+	}
+}
+
+func TestGetPriceHistory_Visibility(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusInactive}, prices: []int64{100, 90}}
+	svc := &AdService{repo: repo}
+	if _, err := svc.GetPriceHistory(context.Background(), "ad1", "stranger"); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound for hidden ad, got %v", err)
+	}
+	points, err := svc.GetPriceHistory(context.Background(), "ad1", "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 2 || points[1].Price != 90 {
+		t.Errorf("unexpected history %+v", points)
+	}
+}
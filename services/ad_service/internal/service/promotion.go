@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidPromotion = errors.New("invalid promotion period")
+	ErrInvalidAmount    = errors.New("invalid amount")
+	// ErrAdNotActive — продвигать и поднимать можно только опубликованные объявления.
+	ErrAdNotActive = errors.New("ad is not active")
+	// ErrBumpCooldown — объявление поднимали недавно; в тексте ошибки время следующей попытки.
+	ErrBumpCooldown = errors.New("ad was bumped recently")
+)
+
+const (
+	maxPromotionDays = 30
+	// defaultPromotionDayPrice — цена дня продвижения во внутренних кредитах, если Config её не задаёт.
+	defaultPromotionDayPrice = 100
+	defaultBumpCooldown      = 24 * time.Hour
+	// maxCredit ограничивает одно начисление, чтобы опечатка администратора не переполнила баланс.
+	maxCredit = 1_000_000_000
+)
+
+func (s *AdService) promotionDayPrice() int64 {
+	if s.cfg.PromotionDayPrice > 0 {
+		return s.cfg.PromotionDayPrice
+	}
+	return defaultPromotionDayPrice
+}
+
+func (s *AdService) bumpCooldown() time.Duration {
+	if s.cfg.BumpCooldown > 0 {
+		return s.cfg.BumpCooldown
+	}
+	return defaultBumpCooldown
+}
+
+// activeOwnAd блокирует объявление и проверяет, что это опубликованное объявление userID.
+func (s *AdService) activeOwnAd(ctx context.Context, adID, userID string) (*model.Ad, error) {
+	ad, err := s.repo.GetForUpdate(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAdNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ad.AuthorID != userID {
+		return nil, ErrPermissionDenied
+	}
+	if ad.Status != model.StatusActive || !ad.ExpiresAt.After(time.Now()) || ad.HiddenAt != nil {
+		return nil, ErrAdNotActive
+	}
+	return ad, nil
+}
+
+// PromoteAd(ad_id, user_id, days) списывает с баланса владельца цену days дней и закрепляет
+// объявление в начале выдачи ListAds. Повторное продвижение продлевает текущее.
+// Возвращает объявление и остаток баланса.
+func (s *AdService) PromoteAd(ctx context.Context, adID, userID string, days int) (*model.Ad, int64, error) {
+	if days < 1 || days > maxPromotionDays {
+		return nil, 0, fmt.Errorf("%w: days must be in 1..%d", ErrInvalidPromotion, maxPromotionDays)
+	}
+	var ad *model.Ad
+	var balance int64
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		if ad, err = tx.activeOwnAd(ctx, adID, userID); err != nil {
+			return err
+		}
+		cost := int64(days) * tx.promotionDayPrice()
+		entry := &model.LedgerEntry{UserID: userID, Amount: -cost, Reason: model.LedgerPromotion, AdID: &ad.ID, ActorID: userID,
+			Comment: fmt.Sprintf("продвижение на %d дн.", days)}
+		if err := tx.repo.AddLedgerEntry(ctx, entry); err != nil {
+			return err
+		}
+		start := time.Now()
+		if ad.Promoted(start) {
+			start = *ad.PromotedUntil
+		}
+		until := start.Add(time.Duration(days) * 24 * time.Hour)
+		if err := tx.repo.SetPromotion(ctx, ad.ID, until); err != nil {
+			return err
+		}
+		ad.PromotedUntil, balance = &until, entry.BalanceAfter
+		return tx.emit(ctx, model.EventAdPromoted, ad.ID, userID, promotion{Days: days, Cost: cost, Until: until})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ad, balance, nil
+}
+
+// BumpAd(ad_id, user_id) бесплатно поднимает объявление в сортировке newest,
+// не чаще раза в Config.BumpCooldown.
+func (s *AdService) BumpAd(ctx context.Context, adID, userID string) (*model.Ad, error) {
+	var ad *model.Ad
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		if ad, err = tx.activeOwnAd(ctx, adID, userID); err != nil {
+			return err
+		}
+		now := time.Now()
+		if next := ad.BumpedAt.Add(tx.bumpCooldown()); now.Before(next) {
+			return fmt.Errorf("%w: next bump at %s", ErrBumpCooldown, next.UTC().Format(time.RFC3339))
+		}
+		if err := tx.repo.BumpAd(ctx, ad.ID, now); err != nil {
+			return err
+		}
+		ad.BumpedAt = now
+		return tx.emit(ctx, model.EventAdBumped, ad.ID, userID, bump{BumpedAt: now})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ad, nil
+}
+
+// CreditBalance(admin_id, user_id, amount, comment) начисляет кредиты на баланс пользователя,
+// только для администраторов. Платёжной системы нет: пополнение — ручная операция.
+func (s *AdService) CreditBalance(ctx context.Context, adminID, userID string, amount int64, comment string) (*model.LedgerEntry, error) {
+	if !s.isAdmin(adminID) {
+		return nil, ErrPermissionDenied
+	}
+	if amount <= 0 || amount > maxCredit {
+		return nil, fmt.Errorf("%w: amount must be in 1..%d", ErrInvalidAmount, maxCredit)
+	}
+	entry := &model.LedgerEntry{UserID: userID, Amount: amount, Reason: model.LedgerTopUp, ActorID: adminID, Comment: comment}
+	err := s.inTx(ctx, func(tx *AdService) error {
+		return tx.repo.AddLedgerEntry(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetBalance(user_id) — текущий баланс и страница операций по нему, новые первыми.
+func (s *AdService) GetBalance(ctx context.Context, userID string, limit, offset int) (int64, []model.LedgerEntry, int, error) {
+	balance, err := s.repo.GetBalance(ctx, userID)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	entries, total, err := s.repo.ListLedger(ctx, userID, limit, offset)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	return balance, entries, total, nil
+}
+
+// ExpirePromotions снимает закрепление с объявлений, у которых закончилось продвижение,
+// пачками по batch штук и возвращает их число.
+func (s *AdService) ExpirePromotions(ctx context.Context, batch int) (int, error) {
+	total := 0
+	for {
+		n, err := s.repo.ExpirePromotions(ctx, time.Now(), batch)
+		total += n
+		if err != nil || n < batch {
+			return total, err
+		}
+	}
+}
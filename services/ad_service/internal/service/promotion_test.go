@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+func activeAd() *model.Ad {
+	return &model.Ad{ID: "ad1", AuthorID: "u1", Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour), BumpedAt: time.Now().Add(-48 * time.Hour)}
+}
+
+func TestPromoteAd_ChargesBalanceAndExtends(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd(), balance: 500}
+	svc := &AdService{repo: repo, cfg: Config{PromotionDayPrice: 100}}
+	ad, balance, err := svc.PromoteAd(context.Background(), "ad1", "u1", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if balance != 200 || repo.ledger[0].Amount != -300 || repo.ledger[0].Reason != model.LedgerPromotion || *repo.ledger[0].AdID != "ad1" {
+		t.Errorf("unexpected charge: balance %d, ledger %+v", balance, repo.ledger)
+	}
+	if !ad.Promoted(time.Now()) || repo.promotedUntil == nil || repo.promotedUntil.Sub(time.Now()) < 71*time.Hour {
+		t.Errorf("expected promotion for 3 days, got %v", repo.promotedUntil)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != model.EventAdPromoted {
+		t.Errorf("expected ad.promoted event, got %+v", repo.events)
+	}
+
+	// повторное продвижение продлевает действующее
+	first := *repo.promotedUntil
+	if _, _, err := svc.PromoteAd(context.Background(), "ad1", "u1", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := repo.promotedUntil.Sub(first); got != 24*time.Hour {
+		t.Errorf("expected extension by one day, got %v", got)
+	}
+
+	if _, _, err := svc.PromoteAd(context.Background(), "ad1", "u1", 2); !errors.Is(err, repository.ErrInsufficientFunds) || !repo.rolledBack {
+		t.Errorf("expected ErrInsufficientFunds and rollback, got %v", err)
+	}
+}
+
+func TestPromoteAd_Rejects(t *testing.T) {
+	archived := activeAd()
+	archived.Status = model.StatusArchived
+	cases := []struct {
+		name string
+		ad   *model.Ad
+		user string
+		days int
+		want error
+	}{
+		{"zero days", activeAd(), "u1", 0, ErrInvalidPromotion},
+		{"too long", activeAd(), "u1", 31, ErrInvalidPromotion},
+		{"not owner", activeAd(), "u2", 1, ErrPermissionDenied},
+		{"not active", archived, "u1", 1, ErrAdNotActive},
+		{"missing", nil, "u1", 1, ErrAdNotFound},
+	}
+	for _, c := range cases {
+		repo := &stubRepo{getAd: c.ad, balance: 10000}
+		svc := &AdService{repo: repo}
+		if _, _, err := svc.PromoteAd(context.Background(), "ad1", c.user, c.days); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+		if repo.balance != 10000 {
+			t.Errorf("%s: balance must not change", c.name)
+		}
+	}
+}
+
+func TestBumpAd_Cooldown(t *testing.T) {
+	repo := &stubRepo{getAd: activeAd()}
+	svc := &AdService{repo: repo}
+	if _, err := svc.BumpAd(context.Background(), "ad1", "u2"); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	ad, err := svc.BumpAd(context.Background(), "ad1", "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if time.Since(ad.BumpedAt) > time.Minute || !repo.bumpedAt.Equal(ad.BumpedAt) {
+		t.Errorf("expected fresh bumped_at, got %v", repo.bumpedAt)
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != model.EventAdBumped {
+		t.Errorf("expected ad.bumped event, got %+v", repo.events)
+	}
+	// стаб возвращает то же объявление, так что следующая попытка упирается в паузу
+	if _, err := svc.BumpAd(context.Background(), "ad1", "u1"); !errors.Is(err, ErrBumpCooldown) {
+		t.Errorf("expected ErrBumpCooldown, got %v", err)
+	}
+}
+
+func TestCreditBalance(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	if _, err := svc.CreditBalance(context.Background(), "u1", "u1", 100, ""); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := svc.CreditBalance(context.Background(), "admin", "u1", 0, ""); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected ErrInvalidAmount, got %v", err)
+	}
+	e, err := svc.CreditBalance(context.Background(), "admin", "u1", 700, "бонус")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.BalanceAfter != 700 || e.Reason != model.LedgerTopUp || e.ActorID != "admin" {
+		t.Errorf("unexpected entry %+v", e)
+	}
+	balance, entries, total, err := svc.GetBalance(context.Background(), "u1", 20, 0)
+	if err != nil || balance != 700 || total != 1 || len(entries) != 1 {
+		t.Errorf("unexpected balance %d, %d entries, total %d, err %v", balance, len(entries), total, err)
+	}
+}
+
+func TestListAds_PinsPromoted(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	if _, err := svc.ListAds(context.Background(), Filters{Sort: repository.SortPriceAsc}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !repo.lastSearch.PinPromoted {
+		t.Error("expected promoted ads to be pinned")
+	}
+}
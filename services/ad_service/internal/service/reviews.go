@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidRating = errors.New("rating must be between 1 and 5")
+	ErrOwnAdReview   = errors.New("cannot review your own ad")
+)
+
+// CreateReview(user_id, ad_id, rating, comment?)
+// Один отзыв на объявление от одного пользователя; на свои объявления отзыв оставить нельзя.
+// После записи пересчитывается средний рейтинг продавца на всех его объявлениях.
+func (s *AdService) CreateReview(ctx context.Context, userID, adID string, rating int, comment string) (*model.Review, error) {
+	if userID == "" || adID == "" {
+		return nil, errors.New("user_id and ad_id are required")
+	}
+	if rating < 1 || rating > 5 {
+		return nil, ErrInvalidRating
+	}
+	ad, err := s.repo.Get(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+	if ad.AuthorID == userID {
+		return nil, ErrOwnAdReview
+	}
+	rv := &model.Review{AdID: adID, ReviewerID: userID, Rating: rating}
+	if c := strings.TrimSpace(comment); c != "" {
+		rv.Comment = &c
+	}
+	if err := s.repo.CreateReview(ctx, rv, ad.AuthorID); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// DeleteReview(user_id, review_id) — удалить можно только свой отзыв.
+func (s *AdService) DeleteReview(ctx context.Context, userID, reviewID string) error {
+	return s.repo.DeleteReview(ctx, reviewID, userID)
+}
+
+// ListAdReviews(ad_id, limit, offset)
+func (s *AdService) ListAdReviews(ctx context.Context, adID string, limit, offset int) ([]model.Review, int, error) {
+	return s.repo.ListReviewsByAd(ctx, adID, limit, offset)
+}
+
+// ListSellerReviews(seller_id, limit, offset) — отзывы на все объявления продавца.
+func (s *AdService) ListSellerReviews(ctx context.Context, sellerID string, limit, offset int) ([]model.Review, int, error) {
+	return s.repo.ListReviewsBySeller(ctx, sellerID, limit, offset)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+	"78-pflops/services/ad_service/internal/repository"
+)
+
+func TestCreateReview_Success(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}}
+	svc := &AdService{repo: repo}
+	rv, err := svc.CreateReview(context.Background(), "buyer", "ad1", 5, "  отличный продавец ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rv.ID == "" || rv.Rating != 5 {
+		t.Errorf("unexpected review %+v", rv)
+	}
+	if rv.Comment == nil || *rv.Comment != "отличный продавец" {
+		t.Errorf("expected trimmed comment")
+	}
+}
+
+func TestCreateReview_OwnAd(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}}
+	svc := &AdService{repo: repo}
+	if _, err := svc.CreateReview(context.Background(), "seller", "ad1", 5, ""); !errors.Is(err, ErrOwnAdReview) {
+		t.Fatalf("expected ErrOwnAdReview, got %v", err)
+	}
+	if len(repo.reviews) != 0 {
+		t.Errorf("review must not be stored")
+	}
+}
+
+func TestCreateReview_InvalidRating(t *testing.T) {
+	svc := &AdService{repo: &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}}}
+	for _, r := range []int{0, 6} {
+		if _, err := svc.CreateReview(context.Background(), "buyer", "ad1", r, ""); !errors.Is(err, ErrInvalidRating) {
+			t.Errorf("rating %d: expected ErrInvalidRating, got %v", r, err)
+		}
+	}
+}
+
+func TestCreateReview_Duplicate(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}, reviewErr: repository.ErrReviewExists}
+	svc := &AdService{repo: repo}
+	if _, err := svc.CreateReview(context.Background(), "buyer", "ad1", 4, ""); !errors.Is(err, repository.ErrReviewExists) {
+		t.Fatalf("expected ErrReviewExists, got %v", err)
+	}
+}
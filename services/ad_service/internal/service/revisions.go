@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrRevisionNotFound — у объявления нет правки с таким номером.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// diffAd сравнивает переданные в UpdateAd значения с текущими и возвращает только реально изменённые поля.
+func diffAd(cur *model.Ad, title, description *string, price *int64, categoryID, condition, status *string, loc *model.Location, attrs map[string]string) []model.FieldChange {
+	var diff []model.FieldChange
+	add := func(field, old string, val *string) {
+		if val != nil && *val != old {
+			diff = append(diff, model.FieldChange{Field: field, Old: old, New: *val})
+		}
+	}
+	add("title", cur.Title, title)
+	add("description", cur.Description, description)
+	if price != nil {
+		p := strconv.FormatInt(*price, 10)
+		add("price", strconv.FormatInt(cur.Price, 10), &p)
+	}
+	add("category_id", cur.CategoryID, categoryID)
+	add("condition", cur.Condition, condition)
+	add("status", cur.Status, status)
+	if loc != nil {
+		l := locationString(*loc)
+		add("location", locationString(cur.Location), &l)
+	}
+	if attrs != nil {
+		a := attributesString(attrs)
+		add("attributes", attributesString(cur.Attributes), &a)
+	}
+	return diff
+}
+
+// attributesString — характеристики строками «ключ=значение» по порядку ключей.
+func attributesString(attrs map[string]string) string {
+	lines := make([]string, 0, len(attrs))
+	for _, k := range slices.Sorted(maps.Keys(attrs)) {
+		lines = append(lines, k+"="+attrs[k])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// diffImages сравнивает список изображений до и после ReplaceImages (порядок важен).
+func diffImages(before []model.AdImage, after []string) (model.FieldChange, bool) {
+	old := make([]string, 0, len(before))
+	for _, img := range before {
+		old = append(old, img.URL)
+	}
+	if slices.Equal(old, after) {
+		return model.FieldChange{}, false
+	}
+	return model.FieldChange{Field: "images", Old: strings.Join(old, "\n"), New: strings.Join(after, "\n")}, true
+}
+
+func (s *AdService) recordRevision(ctx context.Context, adID, actorID string, changes []model.FieldChange) error {
+	return s.repo.InsertRevision(ctx, &model.AdRevision{AdID: adID, ActorID: actorID, Changes: changes})
+}
+
+// checkRevisionAccess пускает к истории правок только владельца и администраторов.
+// История удалённого объявления остаётся доступной до его окончательной очистки.
+func (s *AdService) checkRevisionAccess(ctx context.Context, adID, userID string) error {
+	ad, err := s.repo.Get(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		ad, err = s.repo.GetDeleted(ctx, adID)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAdNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if ad.AuthorID != userID && !s.isAdmin(userID) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// ListAdRevisions(ad_id, user_id) — правки объявления, новые первыми.
+func (s *AdService) ListAdRevisions(ctx context.Context, adID, userID string, limit, offset int) ([]model.AdRevision, int, error) {
+	if err := s.checkRevisionAccess(ctx, adID, userID); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListRevisions(ctx, adID, limit, offset)
+}
+
+// GetAdRevision(ad_id, user_id, revision) — одна правка по номеру.
+func (s *AdService) GetAdRevision(ctx context.Context, adID, userID string, revision int) (*model.AdRevision, error) {
+	if err := s.checkRevisionAccess(ctx, adID, userID); err != nil {
+		return nil, err
+	}
+	rev, err := s.repo.GetRevision(ctx, adID, revision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRevisionNotFound
+	}
+	return rev, err
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestUpdateAd_RecordsRevisionDiff(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Title: "Bike", Description: "Red", Price: 100, Status: model.StatusActive, ExpiresAt: time.Now().Add(time.Hour)}}
+	svc := &AdService{repo: repo}
+	title, desc, price, st := "Bike", "Blue", int64(90), model.StatusSold
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", &title, &desc, &price, nil, nil, &st, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.revisions) != 1 {
+		t.Fatalf("expected one revision, got %d", len(repo.revisions))
+	}
+	rev := repo.revisions[0]
+	want := []model.FieldChange{
+		{Field: "description", Old: "Red", New: "Blue"},
+		{Field: "price", Old: "100", New: "90"},
+		{Field: "status", Old: model.StatusActive, New: model.StatusSold},
+	}
+	if rev.ActorID != "u1" || len(rev.Changes) != len(want) {
+		t.Fatalf("unexpected revision %+v", rev)
+	}
+	for i := range want {
+		if rev.Changes[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], rev.Changes[i])
+		}
+	}
+}
+
+func TestUpdateAd_NoRevisionWithoutChanges(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "u1", Title: "Bike"}}
+	svc := &AdService{repo: repo}
+	title := "Bike"
+	if err := svc.UpdateAd(context.Background(), "ad1", "u1", &title, nil, nil, nil, nil, nil, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.revisions) != 0 {
+		t.Errorf("unchanged values must not create a revision")
+	}
+}
+
+func TestReplaceImages_RecordsRevision(t *testing.T) {
+	repo := &stubRepo{
+		getAd:      &model.Ad{ID: "ad1", AuthorID: "u1"},
+		listImages: []model.AdImage{{URL: "a.jpg"}, {URL: "b.jpg"}},
+	}
+	svc := &AdService{repo: repo}
+	if err := svc.ReplaceImages(context.Background(), "ad1", "u1", []string{"b.jpg", "", "c.jpg"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := model.FieldChange{Field: "images", Old: "a.jpg\nb.jpg", New: "b.jpg\nc.jpg"}
+	if len(repo.revisions) != 1 || len(repo.revisions[0].Changes) != 1 || repo.revisions[0].Changes[0] != want {
+		t.Fatalf("unexpected revisions %+v", repo.revisions)
+	}
+
+	if err := svc.ReplaceImages(context.Background(), "ad1", "u1", []string{"a.jpg", "b.jpg"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.revisions) != 1 {
+		t.Errorf("the same image list must not create a revision")
+	}
+}
+
+func TestAdRevisions_Access(t *testing.T) {
+	repo := &stubRepo{
+		getAd:     &model.Ad{ID: "ad1", AuthorID: "owner"},
+		revisions: []model.AdRevision{{AdID: "ad1", Revision: 1}, {AdID: "ad1", Revision: 2}},
+	}
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	ctx := context.Background()
+
+	for _, user := range []string{"owner", "admin"} {
+		if list, total, err := svc.ListAdRevisions(ctx, "ad1", user, 10, 0); err != nil || total != 2 || len(list) != 2 {
+			t.Errorf("%s: expected 2 revisions, got %d, %v", user, total, err)
+		}
+	}
+	if _, _, err := svc.ListAdRevisions(ctx, "ad1", "buyer", 10, 0); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for a stranger, got %v", err)
+	}
+	if _, err := svc.GetAdRevision(ctx, "ad1", "buyer", 1); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for a stranger, got %v", err)
+	}
+	if rev, err := svc.GetAdRevision(ctx, "ad1", "owner", 2); err != nil || rev.Revision != 2 {
+		t.Errorf("expected revision 2, got %+v, %v", rev, err)
+	}
+	if _, err := svc.GetAdRevision(ctx, "ad1", "owner", 3); !errors.Is(err, ErrRevisionNotFound) {
+		t.Errorf("expected ErrRevisionNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidSavedSearch   = errors.New("saved search name must be 1..100 characters")
+	ErrSavedSearchNotFound  = errors.New("saved search not found")
+	ErrTooManySavedSearches = errors.New("saved search limit reached")
+)
+
+const (
+	maxSavedSearchName = 100
+	// maxSavedSearches ограничивает число сохранённых поисков пользователя: каждый проверяется
+	// для всех новых объявлений.
+	maxSavedSearches = 20
+)
+
+// prepareSavedSearch проверяет имя и фильтры и заменяет slug категории на id.
+func (s *AdService) prepareSavedSearch(ctx context.Context, ss *model.SavedSearch) error {
+	ss.Name = strings.TrimSpace(ss.Name)
+	if ss.Name == "" || utf8.RuneCountInString(ss.Name) > maxSavedSearchName {
+		return ErrInvalidSavedSearch
+	}
+	if err := validateFilters(Filters{PriceMin: ss.PriceMin, PriceMax: ss.PriceMax, Condition: ss.Condition}); err != nil {
+		return err
+	}
+	if ss.CategoryID == nil {
+		return nil
+	}
+	category, err := s.repo.GetCategory(ctx, *ss.CategoryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUnknownCategory
+	}
+	if err != nil {
+		return err
+	}
+	ss.CategoryID = &category.ID
+	return nil
+}
+
+// CreateSavedSearch сохраняет фильтры под именем; новые объявления проверяет фоновый матчер.
+func (s *AdService) CreateSavedSearch(ctx context.Context, ss *model.SavedSearch) error {
+	if err := s.prepareSavedSearch(ctx, ss); err != nil {
+		return err
+	}
+	// счётчик читается под блокировкой пользователя, иначе параллельные запросы обойдут предел
+	return s.inTx(ctx, func(tx *AdService) error {
+		n, err := tx.repo.LockSavedSearches(ctx, ss.UserID)
+		if err != nil {
+			return err
+		}
+		if n >= maxSavedSearches {
+			return ErrTooManySavedSearches
+		}
+		return tx.repo.CreateSavedSearch(ctx, ss)
+	})
+}
+
+// UpdateSavedSearch заменяет имя и фильтры; найденные ранее совпадения сохраняются.
+func (s *AdService) UpdateSavedSearch(ctx context.Context, ss *model.SavedSearch) error {
+	if err := s.prepareSavedSearch(ctx, ss); err != nil {
+		return err
+	}
+	err := s.repo.UpdateSavedSearch(ctx, ss)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSavedSearchNotFound
+	}
+	return err
+}
+
+// DeleteSavedSearch(id, user_id)
+func (s *AdService) DeleteSavedSearch(ctx context.Context, id, userID string) error {
+	err := s.repo.DeleteSavedSearch(ctx, id, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSavedSearchNotFound
+	}
+	return err
+}
+
+// ListSavedSearches возвращает сохранённые поиски пользователя и сумму новых совпадений по ним.
+func (s *AdService) ListSavedSearches(ctx context.Context, userID string) ([]model.SavedSearch, int, error) {
+	list, err := s.repo.ListSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := 0
+	for _, ss := range list {
+		total += ss.NewMatches
+	}
+	return list, total, nil
+}
+
+// ListSavedSearchMatches возвращает объявления, найденные сохранённым поиском с последнего
+// просмотра. С markSeen выданные совпадения становятся просмотренными, когда выдана последняя
+// страница: иначе остальные страницы пропали бы из выдачи. Найденное после чтения остаётся новым.
+func (s *AdService) ListSavedSearchMatches(ctx context.Context, id, userID string, limit, offset int, markSeen bool) ([]model.Ad, int, error) {
+	ss, err := s.repo.GetSavedSearch(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) || err == nil && ss.UserID != userID {
+		return nil, 0, ErrSavedSearchNotFound
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	ads, total, newest, err := s.repo.ListSavedSearchMatches(ctx, id, ss.LastSeenAt, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.attachImages(ctx, ads); err != nil {
+		return nil, 0, err
+	}
+	if err := s.markFavorites(ctx, userID, ads); err != nil {
+		return nil, 0, err
+	}
+	if markSeen && offset+len(ads) >= total && !newest.IsZero() {
+		if err := s.repo.MarkSavedSearchSeen(ctx, id, ss.LastSeenAt, newest); err != nil {
+			return nil, 0, err
+		}
+	}
+	return ads, total, nil
+}
+
+// MatchSavedSearches сопоставляет новые объявления из очереди с сохранёнными поисками
+// пачками по batch штук и возвращает число найденных совпадений.
+func (s *AdService) MatchSavedSearches(ctx context.Context, batch int) (int, error) {
+	total := 0
+	for {
+		ads, matches, err := s.repo.MatchQueuedAds(ctx, batch)
+		total += matches
+		if err != nil || ads < batch {
+			return total, err
+		}
+	}
+}
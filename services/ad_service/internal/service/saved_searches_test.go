@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestCreateSavedSearch_Validation(t *testing.T) {
+	repo := &stubRepo{categories: []model.Category{{ID: "c1", Slug: "bikes"}}}
+	svc := &AdService{repo: repo}
+	min, max := int64(100), int64(50)
+	bad := "BROKEN"
+	cases := []struct {
+		ss   model.SavedSearch
+		want error
+	}{
+		{model.SavedSearch{UserID: "u1", Name: "  "}, ErrInvalidSavedSearch},
+		{model.SavedSearch{UserID: "u1", Name: "x", PriceMin: &min, PriceMax: &max}, ErrInvalidFilter},
+		{model.SavedSearch{UserID: "u1", Name: "x", Condition: &bad}, ErrInvalidCondition},
+		{model.SavedSearch{UserID: "u1", Name: "x", CategoryID: strPtr("cars")}, ErrUnknownCategory},
+	}
+	for _, c := range cases {
+		if err := svc.CreateSavedSearch(context.Background(), &c.ss); !errors.Is(err, c.want) {
+			t.Errorf("%+v: expected %v, got %v", c.ss, c.want, err)
+		}
+	}
+	ss := model.SavedSearch{UserID: "u1", Name: " Bikes ", CategoryID: strPtr("bikes")}
+	if err := svc.CreateSavedSearch(context.Background(), &ss); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ss.ID == "" || ss.Name != "Bikes" || *ss.CategoryID != "c1" {
+		t.Errorf("expected trimmed name and category slug resolved to id, got %+v", ss)
+	}
+}
+
+func TestCreateSavedSearch_Limit(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	for i := 0; i < maxSavedSearches; i++ {
+		if err := svc.CreateSavedSearch(context.Background(), &model.SavedSearch{UserID: "u1", Name: "s"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := svc.CreateSavedSearch(context.Background(), &model.SavedSearch{UserID: "u1", Name: "s"}); !errors.Is(err, ErrTooManySavedSearches) {
+		t.Errorf("expected ErrTooManySavedSearches, got %v", err)
+	}
+	if err := svc.CreateSavedSearch(context.Background(), &model.SavedSearch{UserID: "u2", Name: "s"}); err != nil {
+		t.Errorf("limit is per user: %v", err)
+	}
+}
+
+func TestSavedSearch_Ownership(t *testing.T) {
+	repo := &stubRepo{savedSearches: []model.SavedSearch{{ID: "ss1", UserID: "u1", Name: "a"}}}
+	svc := &AdService{repo: repo}
+	if err := svc.UpdateSavedSearch(context.Background(), &model.SavedSearch{ID: "ss1", UserID: "u2", Name: "b"}); !errors.Is(err, ErrSavedSearchNotFound) {
+		t.Errorf("update: expected ErrSavedSearchNotFound, got %v", err)
+	}
+	if err := svc.DeleteSavedSearch(context.Background(), "ss1", "u2"); !errors.Is(err, ErrSavedSearchNotFound) {
+		t.Errorf("delete: expected ErrSavedSearchNotFound, got %v", err)
+	}
+	if _, _, err := svc.ListSavedSearchMatches(context.Background(), "ss1", "u2", 10, 0, true); !errors.Is(err, ErrSavedSearchNotFound) {
+		t.Errorf("matches: expected ErrSavedSearchNotFound, got %v", err)
+	}
+	if len(repo.seen) != 0 {
+		t.Errorf("a stranger must not reset the counter")
+	}
+}
+
+func TestListSavedSearches_TotalNewMatches(t *testing.T) {
+	repo := &stubRepo{savedSearches: []model.SavedSearch{
+		{ID: "ss1", UserID: "u1", NewMatches: 2},
+		{ID: "ss2", UserID: "u1", NewMatches: 3},
+		{ID: "ss3", UserID: "u2", NewMatches: 7},
+	}}
+	svc := &AdService{repo: repo}
+	list, total, err := svc.ListSavedSearches(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 2 || total != 5 {
+		t.Errorf("expected 2 searches with 5 new matches, got %d and %d", len(list), total)
+	}
+}
+
+func TestListSavedSearchMatches_MarkSeen(t *testing.T) {
+	repo := &stubRepo{
+		savedSearches: []model.SavedSearch{{ID: "ss1", UserID: "u1"}},
+		searchAds:     []model.Ad{{ID: "a1"}, {ID: "a2"}},
+	}
+	svc := &AdService{repo: repo}
+	ads, total, err := svc.ListSavedSearchMatches(context.Background(), "ss1", "u1", 10, 0, false)
+	if err != nil || len(ads) != 2 || total != 2 {
+		t.Fatalf("unexpected result %v %d %v", ads, total, err)
+	}
+	if len(repo.seen) != 0 {
+		t.Errorf("counter must be kept without mark_seen")
+	}
+	if _, _, err := svc.ListSavedSearchMatches(context.Background(), "ss1", "u1", 10, 0, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.seen) != 1 || repo.seen[0] != "ss1" {
+		t.Errorf("expected counter reset, got %v", repo.seen)
+	}
+}
+
+func TestListSavedSearchMatches_MarkSeenOnLastPage(t *testing.T) {
+	repo := &stubRepo{
+		savedSearches: []model.SavedSearch{{ID: "ss1", UserID: "u1"}},
+		searchAds:     []model.Ad{{ID: "a1"}, {ID: "a2"}, {ID: "a3"}},
+	}
+	svc := &AdService{repo: repo}
+	ads, total, err := svc.ListSavedSearchMatches(context.Background(), "ss1", "u1", 2, 0, true)
+	if err != nil || len(ads) != 2 || total != 3 {
+		t.Fatalf("unexpected result %v %d %v", ads, total, err)
+	}
+	if len(repo.seen) != 0 {
+		t.Errorf("the first page must not hide the rest, got %v", repo.seen)
+	}
+	if ads, _, err = svc.ListSavedSearchMatches(context.Background(), "ss1", "u1", 2, 2, true); err != nil || len(ads) != 1 {
+		t.Fatalf("unexpected result %v %v", ads, err)
+	}
+	if len(repo.seen) != 1 {
+		t.Errorf("expected matches marked seen after the last page, got %v", repo.seen)
+	}
+}
+
+func TestCreateAd_QueuesForSavedSearches(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	ad, err := svc.CreateAd(context.Background(), "u1", "T", "D", 1, "", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.queued) != 1 || repo.queued[0] != ad.ID {
+		t.Errorf("expected new ad queued for the matcher, got %v", repo.queued)
+	}
+}
+
+func TestMatchSavedSearches_DrainsQueue(t *testing.T) {
+	repo := &stubRepo{matchResults: [][2]int{{2, 3}, {2, 1}, {1, 0}}}
+	svc := &AdService{repo: repo}
+	n, err := svc.MatchSavedSearches(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 4 || len(repo.matchResults) != 0 {
+		t.Errorf("expected 4 matches over three batches, got %d (left %v)", n, repo.matchResults)
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+// ErrRestoreWindowExpired — удалённое объявление уже нельзя восстановить, оно ждёт очистки.
+var ErrRestoreWindowExpired = errors.New("restore window has expired")
+
+// defaultRestoreWindow используется, если Config.RestoreWindow не задан.
+const defaultRestoreWindow = 30 * 24 * time.Hour
+
+func (s *AdService) restoreWindow() time.Duration {
+	if s.cfg.RestoreWindow > 0 {
+		return s.cfg.RestoreWindow
+	}
+	return defaultRestoreWindow
+}
+
+// RestoreAd(ad_id, user_id) возвращает удалённое объявление автору, если с удаления
+// прошло не больше окна восстановления. Чужие и неудалённые объявления — ErrAdNotFound.
+func (s *AdService) RestoreAd(ctx context.Context, adID, userID string) (*model.Ad, error) {
+	var ad *model.Ad
+	err := s.inTx(ctx, func(tx *AdService) error {
+		deleted, err := tx.repo.GetDeleted(ctx, adID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if deleted.AuthorID != userID {
+			return ErrAdNotFound
+		}
+		if time.Since(*deleted.DeletedAt) > tx.restoreWindow() {
+			return ErrRestoreWindowExpired
+		}
+		if err := tx.repo.Restore(ctx, adID); errors.Is(err, pgx.ErrNoRows) {
+			return ErrAdNotFound
+		} else if err != nil {
+			return err
+		}
+		if err := tx.emit(ctx, model.EventAdRestored, adID, userID, struct{}{}); err != nil {
+			return err
+		}
+		ad, err = tx.repo.Get(ctx, adID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ad, nil
+}
+
+// PurgeDeleted окончательно удаляет объявления, у которых истекло окно восстановления,
+// пачками по batch штук и возвращает их число.
+func (s *AdService) PurgeDeleted(ctx context.Context, batch int) (int, error) {
+	before := time.Now().Add(-s.restoreWindow())
+	total := 0
+	for {
+		n, err := s.repo.PurgeDeleted(ctx, before, batch)
+		total += n
+		if err != nil || n < batch {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func deletedAgo(d time.Duration) *model.Ad {
+	at := time.Now().Add(-d)
+	return &model.Ad{ID: "ad1", AuthorID: "author-1", Status: model.StatusActive, DeletedAt: &at}
+}
+
+func TestRestoreAd_WithinWindow(t *testing.T) {
+	repo := &stubRepo{deletedAd: deletedAgo(time.Hour), getAd: &model.Ad{ID: "ad1", AuthorID: "author-1"}}
+	svc := &AdService{repo: repo, cfg: Config{RestoreWindow: 24 * time.Hour}}
+	ad, err := svc.RestoreAd(context.Background(), "ad1", "author-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !repo.restored || ad == nil || ad.ID != "ad1" {
+		t.Errorf("expected the ad to be restored and returned")
+	}
+	if len(repo.events) != 1 || repo.events[0].Type != model.EventAdRestored {
+		t.Errorf("expected ad.restored event, got %v", eventTypes(repo.events))
+	}
+}
+
+func TestRestoreAd_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		deleted *model.Ad
+		user    string
+		want    error
+	}{
+		{"window expired", deletedAgo(48 * time.Hour), "author-1", ErrRestoreWindowExpired},
+		{"not deleted", nil, "author-1", ErrAdNotFound},
+		{"someone else's ad", deletedAgo(time.Hour), "intruder", ErrAdNotFound},
+	}
+	for _, c := range cases {
+		repo := &stubRepo{deletedAd: c.deleted}
+		svc := &AdService{repo: repo, cfg: Config{RestoreWindow: 24 * time.Hour}}
+		if _, err := svc.RestoreAd(context.Background(), "ad1", c.user); !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+		if repo.restored {
+			t.Errorf("%s: ad must stay deleted", c.name)
+		}
+	}
+}
+
+func TestPurgeDeleted_UsesRestoreWindow(t *testing.T) {
+	repo := &stubRepo{purged: []int{2, 2, 1}}
+	svc := &AdService{repo: repo}
+	n, err := svc.PurgeDeleted(context.Background(), 2)
+	if err != nil || n != 5 {
+		t.Fatalf("expected 5 purged ads, got %d, %v", n, err)
+	}
+	cutoff := time.Now().Add(-defaultRestoreWindow)
+	if d := repo.purgeBefore.Sub(cutoff); d < -time.Minute || d > time.Minute {
+		t.Errorf("purge must only touch ads deleted before the restore window, got cutoff %v", repo.purgeBefore)
+	}
+}
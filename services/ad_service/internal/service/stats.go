@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+const (
+	// defaultViewDedupWindow используется, если Config.ViewDedupWindow не задан.
+	defaultViewDedupWindow = 30 * time.Minute
+	// maxBufferedViews ограничивает память буфера, если запись в базу долго не удаётся;
+	// просмотры сверх предела отбрасываются.
+	maxBufferedViews = 100000
+	// defaultStatsDays и maxStatsDays — период GetAdStats и GetSellerStats в днях.
+	defaultStatsDays = 30
+	maxStatsDays     = 365
+)
+
+// ErrContactsUnavailable — контакты продавца открываются только у активных объявлений.
+var ErrContactsUnavailable = errors.New("seller contacts are available only for active ads")
+
+type viewKey struct{ adID, viewerKey string }
+
+type deltaKey struct {
+	adID string
+	day  time.Time
+	kind string
+}
+
+// statsBuffer копит просмотры и счётчики в памяти, чтобы GetAd не писал в базу;
+// FlushStats периодически переносит их в ad_stats_daily.
+type statsBuffer struct {
+	mu     sync.Mutex
+	views  map[viewKey]time.Time // первый просмотр пары в текущей пачке
+	deltas map[deltaKey]int
+}
+
+func newStatsBuffer() *statsBuffer {
+	return &statsBuffer{views: map[viewKey]time.Time{}, deltas: map[deltaKey]int{}}
+}
+
+func utcDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+func (b *statsBuffer) addView(adID, viewerKey string, at time.Time) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	k := viewKey{adID, viewerKey}
+	if _, ok := b.views[k]; !ok && len(b.views) < maxBufferedViews {
+		b.views[k] = at
+	}
+}
+
+func (b *statsBuffer) add(adID, kind string, at time.Time) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.deltas[deltaKey{adID, utcDay(at), kind}]++
+}
+
+// take забирает накопленное и очищает буфер.
+func (b *statsBuffer) take() ([]model.AdView, []model.AdStatDelta) {
+	b.mu.Lock()
+	views, deltas := b.views, b.deltas
+	b.views, b.deltas = map[viewKey]time.Time{}, map[deltaKey]int{}
+	b.mu.Unlock()
+
+	vs := make([]model.AdView, 0, len(views))
+	for k, at := range views {
+		vs = append(vs, model.AdView{AdID: k.adID, ViewerKey: k.viewerKey, At: at})
+	}
+	ds := make([]model.AdStatDelta, 0, len(deltas))
+	for k, n := range deltas {
+		ds = append(ds, model.AdStatDelta{AdID: k.adID, Day: k.day, Kind: k.kind, N: n})
+	}
+	return vs, ds
+}
+
+// putBack возвращает в буфер пачку, которую не удалось записать.
+func (b *statsBuffer) putBack(views []model.AdView, deltas []model.AdStatDelta) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, v := range views {
+		k := viewKey{v.AdID, v.ViewerKey}
+		if at, ok := b.views[k]; !ok && len(b.views) < maxBufferedViews || ok && v.At.Before(at) {
+			b.views[k] = v.At
+		}
+	}
+	for _, d := range deltas {
+		b.deltas[deltaKey{d.AdID, d.Day, d.Kind}] += d.N
+	}
+}
+
+func (s *AdService) viewDedupWindow() time.Duration {
+	if s.cfg.ViewDedupWindow > 0 {
+		return s.cfg.ViewDedupWindow
+	}
+	return defaultViewDedupWindow
+}
+
+// ViewAd — GetAd для открытия карточки: дополнительно учитывает просмотр.
+// Просмотр определяется по viewerID, для анонимов — по clientID (его передаёт шлюз);
+// без обоих, а также просмотры автора не учитываются.
+func (s *AdService) ViewAd(ctx context.Context, adID, viewerID, clientID string) (*model.Ad, error) {
+	ad, err := s.GetAd(ctx, adID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	key := viewerID
+	if key == "" && clientID != "" {
+		key = "anon:" + clientID
+	}
+	if key != "" && viewerID != ad.AuthorID {
+		s.stats.addView(ad.ID, key, time.Now())
+	}
+	return ad, nil
+}
+
+// RecordContactReveal(ad_id, user_id) учитывает показ контактов продавца и возвращает его id.
+// Посторонним контакты показываются только у активных объявлений.
+func (s *AdService) RecordContactReveal(ctx context.Context, adID, userID string) (string, error) {
+	ad, err := s.repo.Get(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrAdNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if ad.AuthorID == userID {
+		return ad.AuthorID, nil
+	}
+	if s.hiddenFrom(ad, userID) {
+		return "", ErrAdNotFound
+	}
+	if ad.Status != model.StatusActive {
+		return "", ErrContactsUnavailable
+	}
+	s.stats.add(adID, model.StatContactReveals, time.Now())
+	return ad.AuthorID, nil
+}
+
+// FlushStats записывает накопленные просмотры и счётчики в дневные агрегаты одной транзакцией
+// и возвращает число засчитанных просмотров. При ошибке пачка возвращается в буфер.
+func (s *AdService) FlushStats(ctx context.Context) (int, error) {
+	if s.stats == nil {
+		return 0, nil
+	}
+	views, deltas := s.stats.take()
+	if len(views) == 0 && len(deltas) == 0 {
+		return 0, nil
+	}
+	var counted int
+	err := s.inTx(ctx, func(tx *AdService) error {
+		var err error
+		if counted, err = tx.repo.CountViews(ctx, views, tx.viewDedupWindow()); err != nil {
+			return err
+		}
+		if err := tx.repo.AddDailyStats(ctx, deltas); err != nil {
+			return err
+		}
+		_, err = tx.repo.PurgeViewDedup(ctx, time.Now().Add(-tx.viewDedupWindow()))
+		return err
+	})
+	if err != nil {
+		s.stats.putBack(views, deltas)
+		return 0, err
+	}
+	return counted, nil
+}
+
+// statsSince возвращает первый день периода из days последних дней, включая сегодняшний.
+func statsSince(days int) time.Time {
+	if days <= 0 {
+		days = defaultStatsDays
+	}
+	if days > maxStatsDays {
+		days = maxStatsDays
+	}
+	return utcDay(time.Now()).AddDate(0, 0, -(days - 1))
+}
+
+// GetAdStats(ad_id, user_id, days) — дневная статистика объявления за последние days дней
+// (сегодня включительно, по UTC). Доступна владельцу и администраторам.
+func (s *AdService) GetAdStats(ctx context.Context, adID, userID string, days int) ([]model.AdDailyStats, error) {
+	ad, err := s.repo.Get(ctx, adID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAdNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ad.AuthorID != userID && !s.isAdmin(userID) {
+		return nil, ErrPermissionDenied
+	}
+	return s.repo.ListDailyStats(ctx, adID, statsSince(days))
+}
+
+// GetSellerStats(user_id, days, limit, offset) — суммы счётчиков по объявлениям продавца
+// за последние days дней, самые просматриваемые первыми.
+func (s *AdService) GetSellerStats(ctx context.Context, userID string, days, limit, offset int) ([]model.AdStatsSummary, int, error) {
+	return s.repo.SellerStats(ctx, userID, statsSince(days), limit, offset)
+}
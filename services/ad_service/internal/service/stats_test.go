@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestViewAd_BuffersDeduplicatedViews(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller", Status: model.StatusActive}}
+	svc := &AdService{repo: repo, stats: newStatsBuffer()}
+	ctx := context.Background()
+	for _, v := range [][2]string{{"u1", ""}, {"u1", ""}, {"", "1.2.3.4"}, {"", "1.2.3.4"}, {"", ""}, {"seller", ""}} {
+		if _, err := svc.ViewAd(ctx, "ad1", v[0], v[1]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(repo.countedViews) != 0 || repo.txCalls != 0 {
+		t.Fatalf("ViewAd must not write to the database")
+	}
+	n, err := svc.FlushStats(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2 || len(repo.countedViews) != 2 {
+		t.Errorf("expected one view of u1 and one anonymous view, got %+v", repo.countedViews)
+	}
+	if n, _ := svc.FlushStats(ctx); n != 0 || repo.txCalls != 1 {
+		t.Errorf("empty buffer must not be flushed")
+	}
+}
+
+func TestFlushStats_PutsBackOnError(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller", Status: model.StatusActive}, statsErr: errors.New("db down")}
+	svc := &AdService{repo: repo, stats: newStatsBuffer()}
+	ctx := context.Background()
+	if _, err := svc.ViewAd(ctx, "ad1", "u1", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.RecordContactReveal(ctx, "ad1", "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.FlushStats(ctx); err == nil {
+		t.Fatalf("expected flush error")
+	}
+	repo.statsErr = nil
+	if n, err := svc.FlushStats(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the view to be retried, got %d %v", n, err)
+	}
+	if len(repo.statDeltas) != 1 || repo.statDeltas[0].Kind != model.StatContactReveals || repo.statDeltas[0].N != 1 {
+		t.Errorf("expected contact reveal to be retried once, got %+v", repo.statDeltas)
+	}
+}
+
+func TestAddFavorite_CountsOnlyNewFavorites(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}}
+	svc := &AdService{repo: repo, stats: newStatsBuffer()}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := svc.AddFavorite(ctx, "u1", "ad1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := svc.FlushStats(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.statDeltas) != 1 || repo.statDeltas[0].Kind != model.StatFavorites || repo.statDeltas[0].N != 1 {
+		t.Errorf("expected one favorite, got %+v", repo.statDeltas)
+	}
+}
+
+func TestGetAdStats_OwnerOnly(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller"}, dailyStats: []model.AdDailyStats{{Views: 3}}}
+	svc := &AdService{repo: repo, cfg: Config{AdminIDs: []string{"admin"}}}
+	if _, err := svc.GetAdStats(context.Background(), "ad1", "stranger", 7); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	for _, user := range []string{"seller", "admin"} {
+		stats, err := svc.GetAdStats(context.Background(), "ad1", user, 7)
+		if err != nil || len(stats) != 1 || stats[0].Views != 3 {
+			t.Errorf("%s: unexpected result %+v %v", user, stats, err)
+		}
+	}
+}
+
+func TestRecordContactReveal_OnlyActiveAds(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad1", AuthorID: "seller", Status: model.StatusActive}}
+	svc := &AdService{repo: repo, stats: newStatsBuffer()}
+	if seller, err := svc.RecordContactReveal(ctx, "ad1", "u1"); err != nil || seller != "seller" {
+		t.Fatalf("got %q, %v", seller, err)
+	}
+	// владелец видит свои контакты в любом статусе, но показ не засчитывается
+	repo.getAd.Status = model.StatusInactive
+	if seller, err := svc.RecordContactReveal(ctx, "ad1", "seller"); err != nil || seller != "seller" {
+		t.Fatalf("owner: got %q, %v", seller, err)
+	}
+	if _, err := svc.RecordContactReveal(ctx, "ad1", "u1"); !errors.Is(err, ErrAdNotFound) {
+		t.Errorf("expected ErrAdNotFound for inactive ad, got %v", err)
+	}
+	repo.getAd.Status = model.StatusSold
+	if _, err := svc.RecordContactReveal(ctx, "ad1", "u1"); !errors.Is(err, ErrContactsUnavailable) {
+		t.Errorf("expected ErrContactsUnavailable for sold ad, got %v", err)
+	}
+	if _, deltas := svc.stats.take(); len(deltas) != 1 {
+		t.Errorf("expected one counted reveal, got %+v", deltas)
+	}
+}
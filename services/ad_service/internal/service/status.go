@@ -0,0 +1,51 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+var (
+	ErrInvalidStatus    = errors.New("unknown ad status")
+	ErrStatusTransition = errors.New("ad status transition not allowed")
+)
+
+// adTransitions — допустимые переходы статусов объявления.
+// SOLD — конечный статус: проданное объявление нельзя вернуть в продажу.
+var adTransitions = map[string][]string{
+	model.StatusActive:   {model.StatusInactive, model.StatusSold, model.StatusArchived},
+	model.StatusInactive: {model.StatusActive, model.StatusSold, model.StatusArchived},
+	model.StatusSold:     {},
+	model.StatusArchived: {model.StatusActive},
+}
+
+// ownerOnly — объявления в этих статусах видят только владелец и администраторы.
+func ownerOnly(status string) bool {
+	return status == model.StatusInactive || status == model.StatusArchived
+}
+
+// publicStatuses — статусы, видимые всем (дополнение к ownerOnly).
+var publicStatuses = []string{model.StatusActive, model.StatusSold}
+
+func validStatus(status string) bool {
+	_, ok := adTransitions[status]
+	return ok
+}
+
+// checkTransition проверяет переход from -> to; переход в тот же статус допустим.
+func checkTransition(from, to string) error {
+	if !validStatus(to) {
+		return ErrInvalidStatus
+	}
+	if from == to {
+		return nil
+	}
+	for _, next := range adTransitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrStatusTransition, from, to)
+}
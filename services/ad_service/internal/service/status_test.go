@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"78-pflops/services/ad_service/internal/model"
+)
+
+func TestCheckTransition(t *testing.T) {
+	cases := []struct {
+		from, to string
+		err      error
+	}{
+		{model.StatusActive, model.StatusSold, nil},
+		{model.StatusInactive, model.StatusActive, nil},
+		{model.StatusArchived, model.StatusActive, nil},
+		{model.StatusSold, model.StatusSold, nil},
+		{model.StatusSold, model.StatusActive, ErrStatusTransition},
+		{model.StatusSold, model.StatusArchived, ErrStatusTransition},
+		{model.StatusActive, "DELETED", ErrInvalidStatus},
+	}
+	for _, c := range cases {
+		if err := checkTransition(c.from, c.to); !errors.Is(err, c.err) {
+			t.Errorf("%s -> %s: expected %v, got %v", c.from, c.to, c.err, err)
+		}
+	}
+}
+
+func TestUpdateAd_ChangesStatus(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad-1", AuthorID: "u1", Status: model.StatusActive}}
+	svc := &AdService{repo: repo}
+	st := model.StatusSold
+	if err := svc.UpdateAd(context.Background(), "ad-1", "u1", nil, nil, nil, nil, nil, &st, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.statusChange) != 3 || repo.statusChange[0] != model.StatusActive || repo.statusChange[1] != model.StatusSold || repo.statusChange[2] != "u1" {
+		t.Errorf("unexpected status change %v", repo.statusChange)
+	}
+}
+
+func TestUpdateAd_RejectsSoldToActive(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad-1", AuthorID: "u1", Status: model.StatusSold}}
+	svc := &AdService{repo: repo}
+	st := model.StatusActive
+	title := "new"
+	err := svc.UpdateAd(context.Background(), "ad-1", "u1", &title, nil, nil, nil, nil, &st, nil, nil)
+	if !errors.Is(err, ErrStatusTransition) {
+		t.Fatalf("expected ErrStatusTransition, got %v", err)
+	}
+	if repo.updateCalls != 0 || repo.statusChange != nil {
+		t.Errorf("nothing should be written on a rejected transition")
+	}
+}
+
+func TestUpdateAd_StatusNotOwner(t *testing.T) {
+	repo := &stubRepo{getAd: &model.Ad{ID: "ad-1", AuthorID: "u1", Status: model.StatusActive}}
+	svc := &AdService{repo: repo}
+	st := model.StatusInactive
+	if err := svc.UpdateAd(context.Background(), "ad-1", "u2", nil, nil, nil, nil, nil, &st, nil, nil); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+}
+
+func TestListAds_StatusVisibility(t *testing.T) {
+	repo := &stubRepo{}
+	svc := &AdService{repo: repo}
+	if _, err := svc.ListAds(context.Background(), Filters{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(repo.lastSearch.Statuses, []string{model.StatusActive}) || repo.lastSearch.AuthorID != nil {
+		t.Errorf("public listing must be ACTIVE only, got %+v", repo.lastSearch)
+	}
+
+	if _, err := svc.ListAds(context.Background(), Filters{Status: model.StatusSold}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied for anonymous non-active listing, got %v", err)
+	}
+
+	if _, err := svc.ListAds(context.Background(), Filters{Status: model.StatusSold, ViewerID: "u1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(repo.lastSearch.Statuses, []string{model.StatusSold}) || repo.lastSearch.AuthorID == nil || *repo.lastSearch.AuthorID != "u1" {
+		t.Errorf("owner listing must be restricted to the viewer's ads, got %+v", repo.lastSearch)
+	}
+}
@@ -22,26 +22,219 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Ad struct {
+// Жизненный цикл объявления; допустимые переходы проверяет AdService.
+type AdStatus int32
+
+const (
+	AdStatus_AD_STATUS_UNSPECIFIED AdStatus = 0
+	AdStatus_AD_STATUS_ACTIVE      AdStatus = 1 // опубликовано, видно в поиске
+	AdStatus_AD_STATUS_INACTIVE    AdStatus = 2 // снято с публикации владельцем, можно вернуть
+	AdStatus_AD_STATUS_SOLD        AdStatus = 3 // продано, конечный статус
+	AdStatus_AD_STATUS_ARCHIVED    AdStatus = 4 // в архиве, можно опубликовать заново
+)
+
+// Enum value maps for AdStatus.
+var (
+	AdStatus_name = map[int32]string{
+		0: "AD_STATUS_UNSPECIFIED",
+		1: "AD_STATUS_ACTIVE",
+		2: "AD_STATUS_INACTIVE",
+		3: "AD_STATUS_SOLD",
+		4: "AD_STATUS_ARCHIVED",
+	}
+	AdStatus_value = map[string]int32{
+		"AD_STATUS_UNSPECIFIED": 0,
+		"AD_STATUS_ACTIVE":      1,
+		"AD_STATUS_INACTIVE":    2,
+		"AD_STATUS_SOLD":        3,
+		"AD_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x AdStatus) Enum() *AdStatus {
+	p := new(AdStatus)
+	*p = x
+	return p
+}
+
+func (x AdStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ad_proto_enumTypes[0].Descriptor()
+}
+
+func (AdStatus) Type() protoreflect.EnumType {
+	return &file_ad_proto_enumTypes[0]
+}
+
+func (x AdStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdStatus.Descriptor instead.
+func (AdStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{0}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_IN_REVIEW   ReportStatus = 2 // объявление скрыто по порогу жалоб и ждёт решения
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 3
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_IN_REVIEW",
+		3: "REPORT_STATUS_RESOLVED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_IN_REVIEW":   2,
+		"REPORT_STATUS_RESOLVED":    3,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ad_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_ad_proto_enumTypes[1]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{1}
+}
+
+// Изображение объявления; position начинается с 1, основное изображение ровно одно.
+type AdImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Condition     string                 `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"` // NEW, USED, REFURBISHED
-	ImageUrls     []string               `protobuf:"bytes,8,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
-	SellerRating  float64                `protobuf:"fixed64,9,opt,name=seller_rating,json=sellerRating,proto3" json:"seller_rating,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *AdImage) Reset() {
+	*x = AdImage{}
+	mi := &file_ad_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdImage) ProtoMessage() {}
+
+func (x *AdImage) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdImage.ProtoReflect.Descriptor instead.
+func (*AdImage) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdImage) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *AdImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type Ad struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price             int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId        string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Condition         string                 `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                  // NEW, USED, REFURBISHED
+	ImageUrls         []string               `protobuf:"bytes,8,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"` // URL из images в том же порядке; оставлено для совместимости
+	SellerRating      float64                `protobuf:"fixed64,9,opt,name=seller_rating,json=sellerRating,proto3" json:"seller_rating,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsFavorite        bool                   `protobuf:"varint,12,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"` // заполняется, если в запросе передан viewer_id
+	SellerReviewCount int32                  `protobuf:"varint,13,opt,name=seller_review_count,json=sellerReviewCount,proto3" json:"seller_review_count,omitempty"`
+	// Фрагменты с подсветкой совпадений (<mark>…</mark>, HTML-экранированы),
+	// заполняются в ListAds при непустом text.
+	TitleHighlight       string            `protobuf:"bytes,14,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionHighlight string            `protobuf:"bytes,15,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	Status               AdStatus          `protobuf:"varint,16,opt,name=status,proto3,enum=ad.AdStatus" json:"status,omitempty"`
+	ExpiresAt            int64             `protobuf:"varint,17,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                           // после этого момента активное объявление уходит в архив; продлевается RenewAd
+	Images               []*AdImage        `protobuf:"bytes,18,rep,name=images,proto3" json:"images,omitempty"`                                                                                   // по возрастанию position
+	Location             *Location         `protobuf:"bytes,19,opt,name=location,proto3" json:"location,omitempty"`                                                                               // не задано — местоположение не указано
+	DistanceKm           *float64          `protobuf:"fixed64,20,opt,name=distance_km,json=distanceKm,proto3,oneof" json:"distance_km,omitempty"`                                                 // расстояние до точки near в ListAds
+	Attributes           map[string]string `protobuf:"bytes,21,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // характеристики по схеме категории, значения в каноническом виде
+	Promoted             bool              `protobuf:"varint,22,opt,name=promoted,proto3" json:"promoted,omitempty"`                                                                              // оплачено продвижение: в ListAds объявление закреплено в начале выдачи
+	PromotedUntil        int64             `protobuf:"varint,23,opt,name=promoted_until,json=promotedUntil,proto3" json:"promoted_until,omitempty"`                                               // 0 — не продвигалось
+	BumpedAt             int64             `protobuf:"varint,24,opt,name=bumped_at,json=bumpedAt,proto3" json:"bumped_at,omitempty"`                                                              // по нему сортирует newest; совпадает с created_at, пока объявление не поднимали
+	Hidden               bool              `protobuf:"varint,25,opt,name=hidden,proto3" json:"hidden,omitempty"`                                                                                  // скрыто модерацией; такие объявления видят только владелец и администраторы
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
 func (x *Ad) Reset() {
 	*x = Ad{}
-	mi := &file_ad_proto_msgTypes[0]
+	mi := &file_ad_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -53,7 +246,7 @@ func (x *Ad) String() string {
 func (*Ad) ProtoMessage() {}
 
 func (x *Ad) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[0]
+	mi := &file_ad_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -66,7 +259,7 @@ func (x *Ad) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ad.ProtoReflect.Descriptor instead.
 func (*Ad) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{0}
+	return file_ad_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Ad) GetId() string {
@@ -146,95 +339,130 @@ func (x *Ad) GetUpdatedAt() int64 {
 	return 0
 }
 
-type CreateAdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Ad) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
 }
 
-func (x *CreateAdRequest) Reset() {
-	*x = CreateAdRequest{}
-	mi := &file_ad_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Ad) GetSellerReviewCount() int32 {
+	if x != nil {
+		return x.SellerReviewCount
+	}
+	return 0
 }
 
-func (x *CreateAdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Ad) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
 }
 
-func (*CreateAdRequest) ProtoMessage() {}
+func (x *Ad) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
 
-func (x *CreateAdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[1]
+func (x *Ad) GetStatus() AdStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return AdStatus_AD_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use CreateAdRequest.ProtoReflect.Descriptor instead.
-func (*CreateAdRequest) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{1}
+func (x *Ad) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
 }
 
-func (x *CreateAdRequest) GetUserId() string {
+func (x *Ad) GetImages() []*AdImage {
 	if x != nil {
-		return x.UserId
+		return x.Images
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateAdRequest) GetTitle() string {
+func (x *Ad) GetLocation() *Location {
 	if x != nil {
-		return x.Title
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateAdRequest) GetDescription() string {
+func (x *Ad) GetDistanceKm() float64 {
+	if x != nil && x.DistanceKm != nil {
+		return *x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Ad) GetAttributes() map[string]string {
 	if x != nil {
-		return x.Description
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateAdRequest) GetPrice() int64 {
+func (x *Ad) GetPromoted() bool {
 	if x != nil {
-		return x.Price
+		return x.Promoted
+	}
+	return false
+}
+
+func (x *Ad) GetPromotedUntil() int64 {
+	if x != nil {
+		return x.PromotedUntil
 	}
 	return 0
 }
 
-type CreateAdResponse struct {
+func (x *Ad) GetBumpedAt() int64 {
+	if x != nil {
+		return x.BumpedAt
+	}
+	return 0
+}
+
+func (x *Ad) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// Местоположение объявления: город и/или регион из встроенного справочника.
+// Регион города подставляется сервисом; без координат объявление с городом получает центр города.
+type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Lat           *float64               `protobuf:"fixed64,3,opt,name=lat,proto3,oneof" json:"lat,omitempty"` // задаются вместе с lon; не дальше 100 км от центра city
+	Lon           *float64               `protobuf:"fixed64,4,opt,name=lon,proto3,oneof" json:"lon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdResponse) Reset() {
-	*x = CreateAdResponse{}
+func (x *Location) Reset() {
+	*x = Location{}
 	mi := &file_ad_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdResponse) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdResponse) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *CreateAdResponse) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,39 +474,63 @@ func (x *CreateAdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdResponse.ProtoReflect.Descriptor instead.
-func (*CreateAdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateAdResponse) GetAd() *Ad {
+func (x *Location) GetCity() string {
 	if x != nil {
-		return x.Ad
+		return x.City
 	}
-	return nil
+	return ""
 }
 
-type GetAdRequest struct {
+func (x *Location) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil && x.Lat != nil {
+		return *x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLon() float64 {
+	if x != nil && x.Lon != nil {
+		return *x.Lon
+	}
+	return 0
+}
+
+// Поиск в радиусе от точки.
+type GeoFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"` // (0, 500]
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAdRequest) Reset() {
-	*x = GetAdRequest{}
+func (x *GeoFilter) Reset() {
+	*x = GeoFilter{}
 	mi := &file_ad_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAdRequest) String() string {
+func (x *GeoFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdRequest) ProtoMessage() {}
+func (*GeoFilter) ProtoMessage() {}
 
-func (x *GetAdRequest) ProtoReflect() protoreflect.Message {
+func (x *GeoFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -290,39 +542,60 @@ func (x *GetAdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdRequest.ProtoReflect.Descriptor instead.
-func (*GetAdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeoFilter.ProtoReflect.Descriptor instead.
+func (*GeoFilter) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetAdRequest) GetId() string {
+func (x *GeoFilter) GetLat() float64 {
 	if x != nil {
-		return x.Id
+		return x.Lat
 	}
-	return ""
+	return 0
 }
 
-type GetAdResponse struct {
+func (x *GeoFilter) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *GeoFilter) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+// Характеристика категории. Действует и в дочерних категориях; одноимённая характеристика
+// дочерней категории подменяет родительскую.
+type AttributeDef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`   // [a-z][a-z0-9_]*, ключ в Ad.attributes
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // отображаемое название
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // enum, number, bool, text
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`                         // варианты для enum
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // категория, в которой объявлена характеристика
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAdResponse) Reset() {
-	*x = GetAdResponse{}
+func (x *AttributeDef) Reset() {
+	*x = AttributeDef{}
 	mi := &file_ad_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAdResponse) String() string {
+func (x *AttributeDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdResponse) ProtoMessage() {}
+func (*AttributeDef) ProtoMessage() {}
 
-func (x *GetAdResponse) ProtoReflect() protoreflect.Message {
+func (x *AttributeDef) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,134 +607,143 @@ func (x *GetAdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdResponse.ProtoReflect.Descriptor instead.
-func (*GetAdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttributeDef.ProtoReflect.Descriptor instead.
+func (*AttributeDef) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetAdResponse) GetAd() *Ad {
+func (x *AttributeDef) GetKey() string {
 	if x != nil {
-		return x.Ad
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type ListAdsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	PriceMin      int64                  `protobuf:"varint,3,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
-	PriceMax      int64                  `protobuf:"varint,4,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
-	Condition     string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
-	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AttributeDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListAdsRequest) Reset() {
-	*x = ListAdsRequest{}
-	mi := &file_ad_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AttributeDef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *ListAdsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AttributeDef) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (*ListAdsRequest) ProtoMessage() {}
-
-func (x *ListAdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[5]
+func (x *AttributeDef) GetOptions() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Options
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListAdsRequest.ProtoReflect.Descriptor instead.
-func (*ListAdsRequest) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{5}
+	return nil
 }
 
-func (x *ListAdsRequest) GetText() string {
+func (x *AttributeDef) GetCategoryId() string {
 	if x != nil {
-		return x.Text
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *ListAdsRequest) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
-	}
-	return ""
+// Фильтр ListAds по характеристике: equals — для enum, bool и text, min/max — для number.
+type AttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Equals        *string                `protobuf:"bytes,2,opt,name=equals,proto3,oneof" json:"equals,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"` // границы включительно
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAdsRequest) GetPriceMin() int64 {
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_ad_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[5]
 	if x != nil {
-		return x.PriceMin
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListAdsRequest) GetPriceMax() int64 {
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttributeFilter) GetKey() string {
 	if x != nil {
-		return x.PriceMax
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAdsRequest) GetCondition() string {
-	if x != nil {
-		return x.Condition
+func (x *AttributeFilter) GetEquals() string {
+	if x != nil && x.Equals != nil {
+		return *x.Equals
 	}
 	return ""
 }
 
-func (x *ListAdsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
+func (x *AttributeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
 	return 0
 }
 
-func (x *ListAdsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
+func (x *AttributeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
 
-type ListAdsResponse struct {
+type AttributeValues struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ads           []*Ad                  `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAdsResponse) Reset() {
-	*x = ListAdsResponse{}
+func (x *AttributeValues) Reset() {
+	*x = AttributeValues{}
 	mi := &file_ad_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAdsResponse) String() string {
+func (x *AttributeValues) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAdsResponse) ProtoMessage() {}
+func (*AttributeValues) ProtoMessage() {}
 
-func (x *ListAdsResponse) ProtoReflect() protoreflect.Message {
+func (x *AttributeValues) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,67 +755,45 @@ func (x *ListAdsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAdsResponse.ProtoReflect.Descriptor instead.
-func (*ListAdsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttributeValues.ProtoReflect.Descriptor instead.
+func (*AttributeValues) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListAdsResponse) GetAds() []*Ad {
+func (x *AttributeValues) GetValues() map[string]string {
 	if x != nil {
-		return x.Ads
+		return x.Values
 	}
 	return nil
 }
 
-func (x *ListAdsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListAdsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListAdsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type UpdateAdRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	AdId          string                  `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
-	UserId        string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                             // optional
-	Description   *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // optional
-	Price         *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                             // optional
-	CategoryId    *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // optional
-	Condition     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                     // optional
-	Status        *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                           // optional (ACTIVE, INACTIVE, SOLD)
+type CreateAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                                                         // id или slug; пусто — категория по умолчанию
+	Location      *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`                                                                               // необязательно
+	Attributes    map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // проверяются по схеме категории
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdRequest) Reset() {
-	*x = UpdateAdRequest{}
+func (x *CreateAdRequest) Reset() {
+	*x = CreateAdRequest{}
 	mi := &file_ad_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdRequest) String() string {
+func (x *CreateAdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdRequest) ProtoMessage() {}
+func (*CreateAdRequest) ProtoMessage() {}
 
-func (x *UpdateAdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateAdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,87 +805,81 @@ func (x *UpdateAdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAdRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdRequest) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateAdRequest) GetAdId() string {
-	if x != nil {
-		return x.AdId
-	}
-	return ""
-}
-
-func (x *UpdateAdRequest) GetUserId() string {
+func (x *CreateAdRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateAdRequest) GetTitle() *wrapperspb.StringValue {
+func (x *CreateAdRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateAdRequest) GetDescription() *wrapperspb.StringValue {
+func (x *CreateAdRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateAdRequest) GetPrice() *wrapperspb.Int64Value {
+func (x *CreateAdRequest) GetPrice() int64 {
 	if x != nil {
 		return x.Price
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateAdRequest) GetCategoryId() *wrapperspb.StringValue {
+func (x *CreateAdRequest) GetCategoryId() string {
 	if x != nil {
 		return x.CategoryId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateAdRequest) GetCondition() *wrapperspb.StringValue {
+func (x *CreateAdRequest) GetLocation() *Location {
 	if x != nil {
-		return x.Condition
+		return x.Location
 	}
 	return nil
 }
 
-func (x *UpdateAdRequest) GetStatus() *wrapperspb.StringValue {
+func (x *CreateAdRequest) GetAttributes() map[string]string {
 	if x != nil {
-		return x.Status
+		return x.Attributes
 	}
 	return nil
 }
 
-type UpdateAdResponse struct {
+type CreateAdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdResponse) Reset() {
-	*x = UpdateAdResponse{}
+func (x *CreateAdResponse) Reset() {
+	*x = CreateAdResponse{}
 	mi := &file_ad_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdResponse) String() string {
+func (x *CreateAdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdResponse) ProtoMessage() {}
+func (*CreateAdResponse) ProtoMessage() {}
 
-func (x *UpdateAdResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,33 +891,42 @@ func (x *UpdateAdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAdResponse.ProtoReflect.Descriptor instead.
+func (*CreateAdResponse) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{8}
 }
 
-type DeleteAdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *CreateAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+type GetAdRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewerId string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // необязательный: текущий пользователь для флага is_favorite
+	// Идентификатор анонимного клиента (шлюз передаёт IP) для учёта просмотров без viewer_id.
+	ClientId      string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdRequest) Reset() {
-	*x = DeleteAdRequest{}
+func (x *GetAdRequest) Reset() {
+	*x = GetAdRequest{}
 	mi := &file_ad_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdRequest) String() string {
+func (x *GetAdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdRequest) ProtoMessage() {}
+func (*GetAdRequest) ProtoMessage() {}
 
-func (x *DeleteAdRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -675,45 +938,53 @@ func (x *DeleteAdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdRequest.ProtoReflect.Descriptor instead.
+func (*GetAdRequest) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteAdRequest) GetAdId() string {
+func (x *GetAdRequest) GetId() string {
 	if x != nil {
-		return x.AdId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteAdRequest) GetUserId() string {
+func (x *GetAdRequest) GetViewerId() string {
 	if x != nil {
-		return x.UserId
+		return x.ViewerId
 	}
 	return ""
 }
 
-type DeleteAdResponse struct {
+func (x *GetAdRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type GetAdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdResponse) Reset() {
-	*x = DeleteAdResponse{}
+func (x *GetAdResponse) Reset() {
+	*x = GetAdResponse{}
 	mi := &file_ad_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdResponse) String() string {
+func (x *GetAdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdResponse) ProtoMessage() {}
+func (*GetAdResponse) ProtoMessage() {}
 
-func (x *DeleteAdResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,33 +996,60 @@ func (x *DeleteAdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdResponse.ProtoReflect.Descriptor instead.
+func (*GetAdResponse) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{10}
 }
 
-type AttachMediaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
-	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
 }
 
-func (x *AttachMediaRequest) Reset() {
-	*x = AttachMediaRequest{}
+type ListAdsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Text                 string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`                                // полнотекстовый запрос; при непустом значении и пустом sort выдача сортируется по релевантности
+	CategoryId           string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`  // id или slug
+	PriceMin             *int64                 `protobuf:"varint,3,opt,name=price_min,json=priceMin,proto3,oneof" json:"price_min,omitempty"` // границы включительно; 0 — тоже граница, не заданное поле — без ограничения
+	PriceMax             *int64                 `protobuf:"varint,4,opt,name=price_max,json=priceMax,proto3,oneof" json:"price_max,omitempty"`
+	Condition            string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"` // NEW, USED, REFURBISHED
+	Page                 int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ViewerId             string                 `protobuf:"bytes,8,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`                                      // необязательный: текущий пользователь для флага is_favorite
+	IncludeSubcategories bool                   `protobuf:"varint,9,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"` // искать также в дочерних категориях category_id
+	PageToken            string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                  // курсор из next_page_token; если задан, page игнорируется
+	// newest (по умолчанию), oldest, price_asc, price_desc, rating (рейтинг продавца), relevance (только с text),
+	// distance (только с near, ближайшие первыми).
+	// Курсор действителен только для той же сортировки.
+	Sort string `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`
+	// По умолчанию ACTIVE. Другие статусы доступны только владельцу: выдача ограничивается
+	// объявлениями viewer_id.
+	Status          AdStatus           `protobuf:"varint,12,opt,name=status,proto3,enum=ad.AdStatus" json:"status,omitempty"`
+	MinSellerRating *float64           `protobuf:"fixed64,13,opt,name=min_seller_rating,json=minSellerRating,proto3,oneof" json:"min_seller_rating,omitempty"` // 0..5; продавцы без отзывов считаются с рейтингом 0
+	City            string             `protobuf:"bytes,14,opt,name=city,proto3" json:"city,omitempty"`                                                        // название из справочника
+	Region          string             `protobuf:"bytes,15,opt,name=region,proto3" json:"region,omitempty"`
+	Near            *GeoFilter         `protobuf:"bytes,16,opt,name=near,proto3" json:"near,omitempty"`             // только объявления с координатами в радиусе; заполняет Ad.distance_km
+	Attributes      []*AttributeFilter `protobuf:"bytes,17,rep,name=attributes,proto3" json:"attributes,omitempty"` // только вместе с category_id
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAdsRequest) Reset() {
+	*x = ListAdsRequest{}
 	mi := &file_ad_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachMediaRequest) String() string {
+func (x *ListAdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachMediaRequest) ProtoMessage() {}
+func (*ListAdsRequest) ProtoMessage() {}
 
-func (x *AttachMediaRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAdsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ad_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -763,46 +1061,5661 @@ func (x *AttachMediaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachMediaRequest.ProtoReflect.Descriptor instead.
-func (*AttachMediaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAdsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdsRequest) Descriptor() ([]byte, []int) {
 	return file_ad_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AttachMediaRequest) GetAdId() string {
+func (x *ListAdsRequest) GetText() string {
 	if x != nil {
-		return x.AdId
+		return x.Text
 	}
 	return ""
 }
 
-func (x *AttachMediaRequest) GetMediaId() string {
+func (x *ListAdsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetPriceMin() int64 {
+	if x != nil && x.PriceMin != nil {
+		return *x.PriceMin
+	}
+	return 0
+}
+
+func (x *ListAdsRequest) GetPriceMax() int64 {
+	if x != nil && x.PriceMax != nil {
+		return *x.PriceMax
+	}
+	return 0
+}
+
+func (x *ListAdsRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAdsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *ListAdsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetStatus() AdStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AdStatus_AD_STATUS_UNSPECIFIED
+}
+
+func (x *ListAdsRequest) GetMinSellerRating() float64 {
+	if x != nil && x.MinSellerRating != nil {
+		return *x.MinSellerRating
+	}
+	return 0
+}
+
+func (x *ListAdsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListAdsRequest) GetNear() *GeoFilter {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+func (x *ListAdsRequest) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListAdsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Ads            []*Ad                  `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`
+	Total          int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`   // пусто на последней странице
+	TotalEstimated bool                   `protobuf:"varint,6,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"` // true, если total — приблизительная оценка (очень большая выборка)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAdsResponse) Reset() {
+	*x = ListAdsResponse{}
+	mi := &file_ad_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdsResponse) ProtoMessage() {}
+
+func (x *ListAdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAdsResponse) GetAds() []*Ad {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *ListAdsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAdsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAdsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAdsResponse) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+// Объявления продавца для страницы профиля, новые первыми.
+type ListAdsByAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // владелец и администраторы видят все статусы, остальные — ACTIVE и SOLD
+	Status        AdStatus               `protobuf:"varint,3,opt,name=status,proto3,enum=ad.AdStatus" json:"status,omitempty"`   // optional: только этот статус
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdsByAuthorRequest) Reset() {
+	*x = ListAdsByAuthorRequest{}
+	mi := &file_ad_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdsByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdsByAuthorRequest) ProtoMessage() {}
+
+func (x *ListAdsByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdsByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*ListAdsByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAdsByAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListAdsByAuthorRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListAdsByAuthorRequest) GetStatus() AdStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AdStatus_AD_STATUS_UNSPECIFIED
+}
+
+func (x *ListAdsByAuthorRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdsByAuthorRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAdsByAuthorRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type UpdateAdRequest struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	AdId        string                  `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId      string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                             // optional
+	Description *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // optional
+	Price       *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                             // optional
+	CategoryId  *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // optional
+	Condition   *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                     // optional
+	Status      AdStatus                `protobuf:"varint,9,opt,name=status,proto3,enum=ad.AdStatus" json:"status,omitempty"`         // optional: UNSPECIFIED — не менять
+	Location    *Location               `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`                      // optional: заменяет местоположение целиком; пустое сообщение — сбросить
+	// optional: заменяет характеристики целиком. При смене категории без этого поля
+	// текущие значения проверяются по схеме новой категории.
+	Attributes    *AttributeValues `protobuf:"bytes,11,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdRequest) Reset() {
+	*x = UpdateAdRequest{}
+	mi := &file_ad_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdRequest) ProtoMessage() {}
+
+func (x *UpdateAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *UpdateAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAdRequest) GetTitle() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetPrice() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetCategoryId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CategoryId
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetCondition() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetStatus() AdStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AdStatus_AD_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateAdRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateAdRequest) GetAttributes() *AttributeValues {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdResponse) Reset() {
+	*x = UpdateAdResponse{}
+	mi := &file_ad_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdResponse) ProtoMessage() {}
+
+func (x *UpdateAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{15}
+}
+
+type DeleteAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdRequest) Reset() {
+	*x = DeleteAdRequest{}
+	mi := &file_ad_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdRequest) ProtoMessage() {}
+
+func (x *DeleteAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *DeleteAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdResponse) Reset() {
+	*x = DeleteAdResponse{}
+	mi := &file_ad_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdResponse) ProtoMessage() {}
+
+func (x *DeleteAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{17}
+}
+
+type AttachMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMediaRequest) Reset() {
+	*x = AttachMediaRequest{}
+	mi := &file_ad_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMediaRequest) ProtoMessage() {}
+
+func (x *AttachMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMediaRequest.ProtoReflect.Descriptor instead.
+func (*AttachMediaRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AttachMediaRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *AttachMediaRequest) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+type AttachMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMediaResponse) Reset() {
+	*x = AttachMediaResponse{}
+	mi := &file_ad_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMediaResponse) ProtoMessage() {}
+
+func (x *AttachMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMediaResponse.ProtoReflect.Descriptor instead.
+func (*AttachMediaResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{19}
+}
+
+type DetachMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachMediaRequest) Reset() {
+	*x = DetachMediaRequest{}
+	mi := &file_ad_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachMediaRequest) ProtoMessage() {}
+
+func (x *DetachMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachMediaRequest.ProtoReflect.Descriptor instead.
+func (*DetachMediaRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DetachMediaRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *DetachMediaRequest) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+type DetachMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachMediaResponse) Reset() {
+	*x = DetachMediaResponse{}
+	mi := &file_ad_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachMediaResponse) ProtoMessage() {}
+
+func (x *DetachMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachMediaResponse.ProtoReflect.Descriptor instead.
+func (*DetachMediaResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{21}
+}
+
+type ReplaceImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // владелец (или админ в будущем)
+	MediaIds      []string               `protobuf:"bytes,3,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"` // новый полный список медиа для объявления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceImagesRequest) Reset() {
+	*x = ReplaceImagesRequest{}
+	mi := &file_ad_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceImagesRequest) ProtoMessage() {}
+
+func (x *ReplaceImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceImagesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReplaceImagesRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ReplaceImagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReplaceImagesRequest) GetMediaIds() []string {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+type ReplaceImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceImagesResponse) Reset() {
+	*x = ReplaceImagesResponse{}
+	mi := &file_ad_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceImagesResponse) ProtoMessage() {}
+
+func (x *ReplaceImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceImagesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{23}
+}
+
+type ReorderImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,3,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"` // все id изображений объявления в новом порядке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderImagesRequest) Reset() {
+	*x = ReorderImagesRequest{}
+	mi := &file_ad_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderImagesRequest) ProtoMessage() {}
+
+func (x *ReorderImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderImagesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReorderImagesRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ReorderImagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ReorderImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*AdImage             `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderImagesResponse) Reset() {
+	*x = ReorderImagesResponse{}
+	mi := &file_ad_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderImagesResponse) ProtoMessage() {}
+
+func (x *ReorderImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderImagesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderImagesResponse) GetImages() []*AdImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type SetPrimaryImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryImageRequest) Reset() {
+	*x = SetPrimaryImageRequest{}
+	mi := &file_ad_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryImageRequest) ProtoMessage() {}
+
+func (x *SetPrimaryImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryImageRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPrimaryImageRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *SetPrimaryImageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPrimaryImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type SetPrimaryImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*AdImage             `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryImageResponse) Reset() {
+	*x = SetPrimaryImageResponse{}
+	mi := &file_ad_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryImageResponse) ProtoMessage() {}
+
+func (x *SetPrimaryImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryImageResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetPrimaryImageResponse) GetImages() []*AdImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type CreateAdWithImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // идентификатор пользователя (уже валидированный снаружи)
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	MediaIds      []string               `protobuf:"bytes,5,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`                                                               // идентификаторы уже загруженных медиа
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                                                         // id или slug; пусто — категория по умолчанию
+	Location      *Location              `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`                                                                               // необязательно
+	Attributes    map[string]string      `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // проверяются по схеме категории
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdWithImagesRequest) Reset() {
+	*x = CreateAdWithImagesRequest{}
+	mi := &file_ad_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdWithImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdWithImagesRequest) ProtoMessage() {}
+
+func (x *CreateAdWithImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdWithImagesRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdWithImagesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateAdWithImagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAdWithImagesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAdWithImagesRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAdWithImagesRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateAdWithImagesRequest) GetMediaIds() []string {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+func (x *CreateAdWithImagesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateAdWithImagesRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateAdWithImagesRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateAdWithImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdWithImagesResponse) Reset() {
+	*x = CreateAdWithImagesResponse{}
+	mi := &file_ad_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdWithImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdWithImagesResponse) ProtoMessage() {}
+
+func (x *CreateAdWithImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdWithImagesResponse.ProtoReflect.Descriptor instead.
+func (*CreateAdWithImagesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateAdWithImagesResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_ad_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_ad_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{31}
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_ad_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_ad_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{33}
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_ad_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListFavoritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFavoritesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFavoritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ads           []*Ad                  `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_ad_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListFavoritesResponse) GetAds() []*Ad {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFavoritesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFavoritesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"` // 1..5
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_ad_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *Review) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // автор отзыва
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_ad_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_ad_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_ad_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_ad_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{40}
+}
+
+type ListAdReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdReviewsRequest) Reset() {
+	*x = ListAdReviewsRequest{}
+	mi := &file_ad_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdReviewsRequest) ProtoMessage() {}
+
+func (x *ListAdReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAdReviewsRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ListAdReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSellerReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellerId      string                 `protobuf:"bytes,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSellerReviewsRequest) Reset() {
+	*x = ListSellerReviewsRequest{}
+	mi := &file_ad_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSellerReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSellerReviewsRequest) ProtoMessage() {}
+
+func (x *ListSellerReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSellerReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListSellerReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSellerReviewsRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *ListSellerReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSellerReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_ad_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Category struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug           string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId       string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // пусто для корневых категорий
+	Archived       bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	Children       []*Category            `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	AdLifetimeDays int32                  `protobuf:"varint,7,opt,name=ad_lifetime_days,json=adLifetimeDays,proto3" json:"ad_lifetime_days,omitempty"` // 0 — как у родителя или по умолчанию
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_ad_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Category) GetAdLifetimeDays() int32 {
+	if x != nil {
+		return x.AdLifetimeDays
+	}
+	return 0
+}
+
+type ListCategoriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_ad_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCategoriesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_ad_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // администратор
+	Slug           string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId       string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                      // optional
+	AdLifetimeDays int32                  `protobuf:"varint,5,opt,name=ad_lifetime_days,json=adLifetimeDays,proto3" json:"ad_lifetime_days,omitempty"` // optional, 0 — как у родителя
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_ad_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetAdLifetimeDays() int32 {
+	if x != nil {
+		return x.AdLifetimeDays
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_ad_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	UserId         string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // администратор
+	Id             string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Slug           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                                             // optional
+	Name           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                             // optional
+	ParentId       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                     // optional, пустая строка — сделать корневой
+	AdLifetimeDays *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=ad_lifetime_days,json=adLifetimeDays,proto3" json:"ad_lifetime_days,omitempty"` // optional, 0 — наследовать от родителя
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_ad_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetSlug() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Slug
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetParentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetAdLifetimeDays() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.AdLifetimeDays
+	}
+	return nil
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_ad_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ArchiveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCategoryRequest) Reset() {
+	*x = ArchiveCategoryRequest{}
+	mi := &file_ad_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCategoryRequest) ProtoMessage() {}
+
+func (x *ArchiveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ArchiveCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ArchiveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchiveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCategoryResponse) Reset() {
+	*x = ArchiveCategoryResponse{}
+	mi := &file_ad_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCategoryResponse) ProtoMessage() {}
+
+func (x *ArchiveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{52}
+}
+
+type GetCategoryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryAttributesRequest) Reset() {
+	*x = GetCategoryAttributesRequest{}
+	mi := &file_ad_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *GetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type GetCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*AttributeDef        `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryAttributesResponse) Reset() {
+	*x = GetCategoryAttributesResponse{}
+	mi := &file_ad_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *GetCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetCategoryAttributesResponse) GetAttributes() []*AttributeDef {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Заменяет собственные характеристики категории; category_id в элементах игнорируется.
+type SetCategoryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // администратор
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes    []*AttributeDef        `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesRequest) Reset() {
+	*x = SetCategoryAttributesRequest{}
+	mi := &file_ad_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *SetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetCategoryAttributesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesRequest) GetAttributes() []*AttributeDef {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type SetCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*AttributeDef        `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesResponse) Reset() {
+	*x = SetCategoryAttributesResponse{}
+	mi := &file_ad_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *SetCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetCategoryAttributesResponse) GetAttributes() []*AttributeDef {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Продление объявления на срок жизни категории; архивное или неактивное снова публикуется.
+type RenewAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewAdRequest) Reset() {
+	*x = RenewAdRequest{}
+	mi := &file_ad_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAdRequest) ProtoMessage() {}
+
+func (x *RenewAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewAdRequest.ProtoReflect.Descriptor instead.
+func (*RenewAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RenewAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *RenewAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RenewAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewAdResponse) Reset() {
+	*x = RenewAdResponse{}
+	mi := &file_ad_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAdResponse) ProtoMessage() {}
+
+func (x *RenewAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewAdResponse.ProtoReflect.Descriptor instead.
+func (*RenewAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RenewAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+// Restore a soft-deleted ad within the restore window (owner only)
+type RestoreAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAdRequest) Reset() {
+	*x = RestoreAdRequest{}
+	mi := &file_ad_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAdRequest) ProtoMessage() {}
+
+func (x *RestoreAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAdRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RestoreAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *RestoreAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAdResponse) Reset() {
+	*x = RestoreAdResponse{}
+	mi := &file_ad_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAdResponse) ProtoMessage() {}
+
+func (x *RestoreAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAdResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RestoreAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+// Revision history of an ad (owner and admins only)
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // title, description, price, category_id, condition, status, images
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // images: URLs separated by newlines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_ad_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type AdRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"` // 1, 2, ... per ad
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdRevision) Reset() {
+	*x = AdRevision{}
+	mi := &file_ad_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdRevision) ProtoMessage() {}
+
+func (x *AdRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdRevision.ProtoReflect.Descriptor instead.
+func (*AdRevision) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AdRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdRevision) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *AdRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *AdRevision) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AdRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AdRevision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAdRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdRevisionsRequest) Reset() {
+	*x = ListAdRevisionsRequest{}
+	mi := &file_ad_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdRevisionsRequest) ProtoMessage() {}
+
+func (x *ListAdRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAdRevisionsRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ListAdRevisionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAdRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAdRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*AdRevision          `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // newest first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdRevisionsResponse) Reset() {
+	*x = ListAdRevisionsResponse{}
+	mi := &file_ad_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdRevisionsResponse) ProtoMessage() {}
+
+func (x *ListAdRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAdRevisionsResponse) GetRevisions() []*AdRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListAdRevisionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAdRevisionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdRevisionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetAdRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdRevisionRequest) Reset() {
+	*x = GetAdRevisionRequest{}
+	mi := &file_ad_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdRevisionRequest) ProtoMessage() {}
+
+func (x *GetAdRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetAdRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetAdRevisionRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *GetAdRevisionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAdRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetAdRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *AdRevision            `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdRevisionResponse) Reset() {
+	*x = GetAdRevisionResponse{}
+	mi := &file_ad_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdRevisionResponse) ProtoMessage() {}
+
+func (x *GetAdRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetAdRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetAdRevisionResponse) GetRevision() *AdRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// Цена объявления, действующая с changed_at; первая точка — цена при создании.
+type PricePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         int64                  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	ChangedAt     int64                  `protobuf:"varint,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePoint) Reset() {
+	*x = PricePoint{}
+	mi := &file_ad_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePoint) ProtoMessage() {}
+
+func (x *PricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PricePoint) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PricePoint) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_ad_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPriceHistoryRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*PricePoint          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_ad_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetPriceHistoryResponse) GetPoints() []*PricePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// Сохранённые фильтры ListAds. Новые ACTIVE-объявления проверяет фоновый матчер,
+// new_matches — сколько совпадений найдено с последнего просмотра (last_seen_at).
+type SavedSearch struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Text                 string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	CategoryId           string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,5,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	PriceMin             *int64                 `protobuf:"varint,6,opt,name=price_min,json=priceMin,proto3,oneof" json:"price_min,omitempty"`
+	PriceMax             *int64                 `protobuf:"varint,7,opt,name=price_max,json=priceMax,proto3,oneof" json:"price_max,omitempty"`
+	Condition            string                 `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`
+	NewMatches           int32                  `protobuf:"varint,9,opt,name=new_matches,json=newMatches,proto3" json:"new_matches,omitempty"`
+	LastSeenAt           int64                  `protobuf:"varint,10,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	CreatedAt            int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_ad_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *SavedSearch) GetPriceMin() int64 {
+	if x != nil && x.PriceMin != nil {
+		return *x.PriceMin
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetPriceMax() int64 {
+	if x != nil && x.PriceMax != nil {
+		return *x.PriceMax
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetNewMatches() int32 {
+	if x != nil {
+		return x.NewMatches
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateSavedSearchRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Text                 string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	CategoryId           string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // id или slug
+	IncludeSubcategories bool                   `protobuf:"varint,5,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	PriceMin             *int64                 `protobuf:"varint,6,opt,name=price_min,json=priceMin,proto3,oneof" json:"price_min,omitempty"`
+	PriceMax             *int64                 `protobuf:"varint,7,opt,name=price_max,json=priceMax,proto3,oneof" json:"price_max,omitempty"`
+	Condition            string                 `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_ad_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateSavedSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *CreateSavedSearchRequest) GetPriceMin() int64 {
+	if x != nil && x.PriceMin != nil {
+		return *x.PriceMin
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetPriceMax() int64 {
+	if x != nil && x.PriceMax != nil {
+		return *x.PriceMax
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type CreateSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchResponse) Reset() {
+	*x = CreateSavedSearchResponse{}
+	mi := &file_ad_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchResponse) ProtoMessage() {}
+
+func (x *CreateSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateSavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+// Заменяет имя и все фильтры сохранённого поиска.
+type UpdateSavedSearchRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Text                 string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CategoryId           string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,6,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	PriceMin             *int64                 `protobuf:"varint,7,opt,name=price_min,json=priceMin,proto3,oneof" json:"price_min,omitempty"`
+	PriceMax             *int64                 `protobuf:"varint,8,opt,name=price_max,json=priceMax,proto3,oneof" json:"price_max,omitempty"`
+	Condition            string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_ad_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateSavedSearchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *UpdateSavedSearchRequest) GetPriceMin() int64 {
+	if x != nil && x.PriceMin != nil {
+		return *x.PriceMin
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetPriceMax() int64 {
+	if x != nil && x.PriceMax != nil {
+		return *x.PriceMax
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type UpdateSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchResponse) Reset() {
+	*x = UpdateSavedSearchResponse{}
+	mi := &file_ad_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchResponse) ProtoMessage() {}
+
+func (x *UpdateSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateSavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_ad_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteSavedSearchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_ad_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{76}
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_ad_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListSavedSearchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSavedSearchesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches   []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	TotalNewMatches int32                  `protobuf:"varint,2,opt,name=total_new_matches,json=totalNewMatches,proto3" json:"total_new_matches,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_ad_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+func (x *ListSavedSearchesResponse) GetTotalNewMatches() int32 {
+	if x != nil {
+		return x.TotalNewMatches
+	}
+	return 0
+}
+
+type ListSavedSearchMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MarkSeen      bool                   `protobuf:"varint,5,opt,name=mark_seen,json=markSeen,proto3" json:"mark_seen,omitempty"` // после последней страницы считать выданные совпадения просмотренными
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchMatchesRequest) Reset() {
+	*x = ListSavedSearchMatchesRequest{}
+	mi := &file_ad_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchMatchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListSavedSearchMatchesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSavedSearchMatchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSavedSearchMatchesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSavedSearchMatchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedSearchMatchesRequest) GetMarkSeen() bool {
+	if x != nil {
+		return x.MarkSeen
+	}
+	return false
+}
+
+type ListSavedSearchMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ads           []*Ad                  `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"` // найденные с last_seen_at, сначала самые новые
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchMatchesResponse) Reset() {
+	*x = ListSavedSearchMatchesResponse{}
+	mi := &file_ad_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchMatchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListSavedSearchMatchesResponse) GetAds() []*Ad {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *ListSavedSearchMatchesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSavedSearchMatchesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSavedSearchMatchesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Счётчики объявления за день (UTC).
+type AdDailyStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Day            string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`      // YYYY-MM-DD
+	Views          int32                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"` // без повторов одного зрителя в пределах окна AD_VIEW_DEDUP_WINDOW
+	Favorites      int32                  `protobuf:"varint,3,opt,name=favorites,proto3" json:"favorites,omitempty"`
+	ContactReveals int32                  `protobuf:"varint,4,opt,name=contact_reveals,json=contactReveals,proto3" json:"contact_reveals,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdDailyStats) Reset() {
+	*x = AdDailyStats{}
+	mi := &file_ad_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdDailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdDailyStats) ProtoMessage() {}
+
+func (x *AdDailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdDailyStats.ProtoReflect.Descriptor instead.
+func (*AdDailyStats) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AdDailyStats) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AdDailyStats) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *AdDailyStats) GetFavorites() int32 {
+	if x != nil {
+		return x.Favorites
+	}
+	return 0
+}
+
+func (x *AdDailyStats) GetContactReveals() int32 {
+	if x != nil {
+		return x.ContactReveals
+	}
+	return 0
+}
+
+type GetAdStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // владелец или администратор
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                  // последние N дней включая сегодня; 0 — 30, не больше 365
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdStatsRequest) Reset() {
+	*x = GetAdStatsRequest{}
+	mi := &file_ad_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdStatsRequest) ProtoMessage() {}
+
+func (x *GetAdStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetAdStatsRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *GetAdStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAdStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetAdStatsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Days                []*AdDailyStats        `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"` // дни без активности пропущены
+	TotalViews          int32                  `protobuf:"varint,2,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	TotalFavorites      int32                  `protobuf:"varint,3,opt,name=total_favorites,json=totalFavorites,proto3" json:"total_favorites,omitempty"`
+	TotalContactReveals int32                  `protobuf:"varint,4,opt,name=total_contact_reveals,json=totalContactReveals,proto3" json:"total_contact_reveals,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetAdStatsResponse) Reset() {
+	*x = GetAdStatsResponse{}
+	mi := &file_ad_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdStatsResponse) ProtoMessage() {}
+
+func (x *GetAdStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetAdStatsResponse) GetDays() []*AdDailyStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAdStatsResponse) GetTotalViews() int32 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
+func (x *GetAdStatsResponse) GetTotalFavorites() int32 {
+	if x != nil {
+		return x.TotalFavorites
+	}
+	return 0
+}
+
+func (x *GetAdStatsResponse) GetTotalContactReveals() int32 {
+	if x != nil {
+		return x.TotalContactReveals
+	}
+	return 0
+}
+
+// Строка панели продавца: суммы за период по одному объявлению.
+type AdStatsSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AdId           string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status         AdStatus               `protobuf:"varint,3,opt,name=status,proto3,enum=ad.AdStatus" json:"status,omitempty"`
+	Views          int32                  `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	Favorites      int32                  `protobuf:"varint,5,opt,name=favorites,proto3" json:"favorites,omitempty"`
+	ContactReveals int32                  `protobuf:"varint,6,opt,name=contact_reveals,json=contactReveals,proto3" json:"contact_reveals,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdStatsSummary) Reset() {
+	*x = AdStatsSummary{}
+	mi := &file_ad_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdStatsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdStatsSummary) ProtoMessage() {}
+
+func (x *AdStatsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdStatsSummary.ProtoReflect.Descriptor instead.
+func (*AdStatsSummary) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdStatsSummary) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *AdStatsSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AdStatsSummary) GetStatus() AdStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AdStatus_AD_STATUS_UNSPECIFIED
+}
+
+func (x *AdStatsSummary) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *AdStatsSummary) GetFavorites() int32 {
+	if x != nil {
+		return x.Favorites
+	}
+	return 0
+}
+
+func (x *AdStatsSummary) GetContactReveals() int32 {
+	if x != nil {
+		return x.ContactReveals
+	}
+	return 0
+}
+
+type GetSellerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSellerStatsRequest) Reset() {
+	*x = GetSellerStatsRequest{}
+	mi := &file_ad_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSellerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSellerStatsRequest) ProtoMessage() {}
+
+func (x *GetSellerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSellerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSellerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetSellerStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetSellerStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetSellerStatsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSellerStatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSellerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ads           []*AdStatsSummary      `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`      // самые просматриваемые первыми
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число объявлений продавца
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSellerStatsResponse) Reset() {
+	*x = GetSellerStatsResponse{}
+	mi := &file_ad_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSellerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSellerStatsResponse) ProtoMessage() {}
+
+func (x *GetSellerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSellerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSellerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetSellerStatsResponse) GetAds() []*AdStatsSummary {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *GetSellerStatsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetSellerStatsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSellerStatsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Учёт показа контактов продавца; для чужих объявлений — только ACTIVE (иначе FailedPrecondition).
+type RecordContactRevealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordContactRevealRequest) Reset() {
+	*x = RecordContactRevealRequest{}
+	mi := &file_ad_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContactRevealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContactRevealRequest) ProtoMessage() {}
+
+func (x *RecordContactRevealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContactRevealRequest.ProtoReflect.Descriptor instead.
+func (*RecordContactRevealRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RecordContactRevealRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *RecordContactRevealRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RecordContactRevealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellerId      string                 `protobuf:"bytes,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordContactRevealResponse) Reset() {
+	*x = RecordContactRevealResponse{}
+	mi := &file_ad_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContactRevealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContactRevealResponse) ProtoMessage() {}
+
+func (x *RecordContactRevealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContactRevealResponse.ProtoReflect.Descriptor instead.
+func (*RecordContactRevealResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RecordContactRevealResponse) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+// Продвижение за внутренние кредиты; повторное продвижение продлевает текущее.
+// Недостаточно средств или объявление не ACTIVE — FailedPrecondition.
+type PromoteAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // владелец
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                  // 1..30
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteAdRequest) Reset() {
+	*x = PromoteAdRequest{}
+	mi := &file_ad_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteAdRequest) ProtoMessage() {}
+
+func (x *PromoteAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteAdRequest.ProtoReflect.Descriptor instead.
+func (*PromoteAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PromoteAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *PromoteAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PromoteAdRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PromoteAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"` // остаток после списания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteAdResponse) Reset() {
+	*x = PromoteAdResponse{}
+	mi := &file_ad_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteAdResponse) ProtoMessage() {}
+
+func (x *PromoteAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteAdResponse.ProtoReflect.Descriptor instead.
+func (*PromoteAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PromoteAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+func (x *PromoteAdResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// Бесплатное поднятие в сортировке newest; чаще AD_BUMP_COOLDOWN — ResourceExhausted.
+type BumpAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpAdRequest) Reset() {
+	*x = BumpAdRequest{}
+	mi := &file_ad_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpAdRequest) ProtoMessage() {}
+
+func (x *BumpAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpAdRequest.ProtoReflect.Descriptor instead.
+func (*BumpAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *BumpAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *BumpAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BumpAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpAdResponse) Reset() {
+	*x = BumpAdResponse{}
+	mi := &file_ad_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpAdResponse) ProtoMessage() {}
+
+func (x *BumpAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpAdResponse.ProtoReflect.Descriptor instead.
+func (*BumpAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *BumpAdResponse) GetAd() *Ad {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+// Операция по балансу: положительная сумма — начисление, отрицательная — списание.
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`         // top_up, promotion
+	AdId          string                 `protobuf:"bytes,4,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"` // для promotion
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	BalanceAfter  int64                  `protobuf:"varint,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_ad_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_ad_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBalanceRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // новые первыми
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_ad_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetBalanceResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Начисление кредитов администратором; платёжной системы нет.
+type CreditBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // администратор
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // > 0
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditBalanceRequest) Reset() {
+	*x = CreditBalanceRequest{}
+	mi := &file_ad_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditBalanceRequest) ProtoMessage() {}
+
+func (x *CreditBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditBalanceRequest.ProtoReflect.Descriptor instead.
+func (*CreditBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreditBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditBalanceRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *CreditBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreditBalanceRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CreditBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LedgerEntry           `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditBalanceResponse) Reset() {
+	*x = CreditBalanceResponse{}
+	mi := &file_ad_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditBalanceResponse) ProtoMessage() {}
+
+func (x *CreditBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditBalanceResponse.ProtoReflect.Descriptor instead.
+func (*CreditBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreditBalanceResponse) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // scam, prohibited, spam, offensive, other
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=ad.ReportStatus" json:"status,omitempty"`
+	Resolution    string                 `protobuf:"bytes,7,opt,name=resolution,proto3" json:"resolution,omitempty"` // ad_hidden, ad_restored, rejected; пусто до рассмотрения
+	ReviewerId    string                 `protobuf:"bytes,8,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    int64                  `protobuf:"varint,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // 0 — не рассмотрена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_ad_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *Report) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Report) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Report) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Report) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *Report) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Report) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+// Жалоба на видимое объявление; повторная до рассмотрения первой — AlreadyExists.
+type ReportAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"` // обязателен для other, до 1000 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportAdRequest) Reset() {
+	*x = ReportAdRequest{}
+	mi := &file_ad_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportAdRequest) ProtoMessage() {}
+
+func (x *ReportAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportAdRequest.ProtoReflect.Descriptor instead.
+func (*ReportAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ReportAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ReportAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportAdRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportAdRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReportAdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportAdResponse) Reset() {
+	*x = ReportAdResponse{}
+	mi := &file_ad_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportAdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportAdResponse) ProtoMessage() {}
+
+func (x *ReportAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportAdResponse.ProtoReflect.Descriptor instead.
+func (*ReportAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ReportAdResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Административные методы модерации: user_id — из AD_ADMIN_IDS, иначе PermissionDenied.
+type ListReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=ad.ReportStatus" json:"status,omitempty"` // UNSPECIFIED — все
+	AdId          string                 `protobuf:"bytes,3,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`               // пусто — по всем объявлениям
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_ad_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListReportsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*Report              `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"` // старые первыми
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_ad_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListReportsResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReportsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Скрыть объявление или вернуть его в выдачу; нерассмотренные жалобы закрываются.
+type ModerateAdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateAdRequest) Reset() {
+	*x = ModerateAdRequest{}
+	mi := &file_ad_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateAdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateAdRequest) ProtoMessage() {}
+
+func (x *ModerateAdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateAdRequest.ProtoReflect.Descriptor instead.
+func (*ModerateAdRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ModerateAdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerateAdRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+func (x *ModerateAdRequest) GetComment() string {
 	if x != nil {
-		return x.MediaId
+		return x.Comment
 	}
 	return ""
 }
 
-type AttachMediaResponse struct {
+type ModerateAdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachMediaResponse) Reset() {
-	*x = AttachMediaResponse{}
-	mi := &file_ad_proto_msgTypes[12]
+func (x *ModerateAdResponse) Reset() {
+	*x = ModerateAdResponse{}
+	mi := &file_ad_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachMediaResponse) String() string {
+func (x *ModerateAdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachMediaResponse) ProtoMessage() {}
+func (*ModerateAdResponse) ProtoMessage() {}
 
-func (x *AttachMediaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[12]
+func (x *ModerateAdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,34 +6726,35 @@ func (x *AttachMediaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachMediaResponse.ProtoReflect.Descriptor instead.
-func (*AttachMediaResponse) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ModerateAdResponse.ProtoReflect.Descriptor instead.
+func (*ModerateAdResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{104}
 }
 
-type DetachMediaRequest struct {
+type RejectReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
-	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportId      string                 `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetachMediaRequest) Reset() {
-	*x = DetachMediaRequest{}
-	mi := &file_ad_proto_msgTypes[13]
+func (x *RejectReportRequest) Reset() {
+	*x = RejectReportRequest{}
+	mi := &file_ad_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachMediaRequest) String() string {
+func (x *RejectReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachMediaRequest) ProtoMessage() {}
+func (*RejectReportRequest) ProtoMessage() {}
 
-func (x *DetachMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[13]
+func (x *RejectReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,46 +6765,54 @@ func (x *DetachMediaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachMediaRequest.ProtoReflect.Descriptor instead.
-func (*DetachMediaRequest) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RejectReportRequest.ProtoReflect.Descriptor instead.
+func (*RejectReportRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DetachMediaRequest) GetAdId() string {
+func (x *RejectReportRequest) GetUserId() string {
 	if x != nil {
-		return x.AdId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DetachMediaRequest) GetMediaId() string {
+func (x *RejectReportRequest) GetReportId() string {
 	if x != nil {
-		return x.MediaId
+		return x.ReportId
 	}
 	return ""
 }
 
-type DetachMediaResponse struct {
+func (x *RejectReportRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RejectReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetachMediaResponse) Reset() {
-	*x = DetachMediaResponse{}
-	mi := &file_ad_proto_msgTypes[14]
+func (x *RejectReportResponse) Reset() {
+	*x = RejectReportResponse{}
+	mi := &file_ad_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachMediaResponse) String() string {
+func (x *RejectReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachMediaResponse) ProtoMessage() {}
+func (*RejectReportResponse) ProtoMessage() {}
 
-func (x *DetachMediaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[14]
+func (x *RejectReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,35 +6823,47 @@ func (x *DetachMediaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachMediaResponse.ProtoReflect.Descriptor instead.
-func (*DetachMediaResponse) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RejectReportResponse.ProtoReflect.Descriptor instead.
+func (*RejectReportResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{106}
 }
 
-type ReplaceImagesRequest struct {
+func (x *RejectReportResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Запись журнала модерации.
+type ModerationAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdId          string                 `protobuf:"bytes,1,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // владелец (или админ в будущем)
-	MediaIds      []string               `protobuf:"bytes,3,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"` // новый полный список медиа для объявления
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	ReportId      string                 `protobuf:"bytes,3,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // пусто для автоматического скрытия
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                  // report, auto_hide, hide, unhide, reject
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplaceImagesRequest) Reset() {
-	*x = ReplaceImagesRequest{}
-	mi := &file_ad_proto_msgTypes[15]
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_ad_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplaceImagesRequest) String() string {
+func (x *ModerationAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplaceImagesRequest) ProtoMessage() {}
+func (*ModerationAction) ProtoMessage() {}
 
-func (x *ReplaceImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[15]
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,94 +6874,85 @@ func (x *ReplaceImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplaceImagesRequest.ProtoReflect.Descriptor instead.
-func (*ReplaceImagesRequest) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ReplaceImagesRequest) GetAdId() string {
+func (x *ModerationAction) GetId() string {
 	if x != nil {
-		return x.AdId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ReplaceImagesRequest) GetUserId() string {
+func (x *ModerationAction) GetAdId() string {
 	if x != nil {
-		return x.UserId
+		return x.AdId
 	}
 	return ""
 }
 
-func (x *ReplaceImagesRequest) GetMediaIds() []string {
+func (x *ModerationAction) GetReportId() string {
 	if x != nil {
-		return x.MediaIds
+		return x.ReportId
 	}
-	return nil
-}
-
-type ReplaceImagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ReplaceImagesResponse) Reset() {
-	*x = ReplaceImagesResponse{}
-	mi := &file_ad_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ModerationAction) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
 }
 
-func (x *ReplaceImagesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ModerationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (*ReplaceImagesResponse) ProtoMessage() {}
-
-func (x *ReplaceImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[16]
+func (x *ModerationAction) GetComment() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Comment
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReplaceImagesResponse.ProtoReflect.Descriptor instead.
-func (*ReplaceImagesResponse) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{16}
+func (x *ModerationAction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-type CreateAdWithImagesRequest struct {
+type ListModerationLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // идентификатор пользователя (уже валидированный снаружи)
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	MediaIds      []string               `protobuf:"bytes,5,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"` // идентификаторы уже загруженных медиа
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdWithImagesRequest) Reset() {
-	*x = CreateAdWithImagesRequest{}
-	mi := &file_ad_proto_msgTypes[17]
+func (x *ListModerationLogRequest) Reset() {
+	*x = ListModerationLogRequest{}
+	mi := &file_ad_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdWithImagesRequest) String() string {
+func (x *ListModerationLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdWithImagesRequest) ProtoMessage() {}
+func (*ListModerationLogRequest) ProtoMessage() {}
 
-func (x *CreateAdWithImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[17]
+func (x *ListModerationLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,68 +6963,64 @@ func (x *CreateAdWithImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdWithImagesRequest.ProtoReflect.Descriptor instead.
-func (*CreateAdWithImagesRequest) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListModerationLogRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationLogRequest) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateAdWithImagesRequest) GetUserId() string {
+func (x *ListModerationLogRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateAdWithImagesRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateAdWithImagesRequest) GetDescription() string {
+func (x *ListModerationLogRequest) GetAdId() string {
 	if x != nil {
-		return x.Description
+		return x.AdId
 	}
 	return ""
 }
 
-func (x *CreateAdWithImagesRequest) GetPrice() int64 {
+func (x *ListModerationLogRequest) GetPage() int32 {
 	if x != nil {
-		return x.Price
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CreateAdWithImagesRequest) GetMediaIds() []string {
+func (x *ListModerationLogRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.MediaIds
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type CreateAdWithImagesResponse struct {
+type ListModerationLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ad            *Ad                    `protobuf:"bytes,1,opt,name=ad,proto3" json:"ad,omitempty"`
+	Actions       []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"` // новые первыми
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdWithImagesResponse) Reset() {
-	*x = CreateAdWithImagesResponse{}
-	mi := &file_ad_proto_msgTypes[18]
+func (x *ListModerationLogResponse) Reset() {
+	*x = ListModerationLogResponse{}
+	mi := &file_ad_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdWithImagesResponse) String() string {
+func (x *ListModerationLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdWithImagesResponse) ProtoMessage() {}
+func (*ListModerationLogResponse) ProtoMessage() {}
 
-func (x *CreateAdWithImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ad_proto_msgTypes[18]
+func (x *ListModerationLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,23 +7031,50 @@ func (x *CreateAdWithImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdWithImagesResponse.ProtoReflect.Descriptor instead.
-func (*CreateAdWithImagesResponse) Descriptor() ([]byte, []int) {
-	return file_ad_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListModerationLogResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationLogResponse) Descriptor() ([]byte, []int) {
+	return file_ad_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CreateAdWithImagesResponse) GetAd() *Ad {
+func (x *ListModerationLogResponse) GetActions() []*ModerationAction {
 	if x != nil {
-		return x.Ad
+		return x.Actions
 	}
 	return nil
 }
 
+func (x *ListModerationLogResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListModerationLogResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListModerationLogResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 var File_ad_proto protoreflect.FileDescriptor
 
 const file_ad_proto_rawDesc = "" +
 	"\n" +
-	"\bad.proto\x12\x02ad\x1a\x1egoogle/protobuf/wrappers.proto\"\xc0\x02\n" +
+	"\bad.proto\x12\x02ad\x1a\x1egoogle/protobuf/wrappers.proto\"f\n" +
+	"\aAdImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"\xa8\a\n" +
 	"\x02Ad\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x14\n" +
@@ -1143,32 +7091,127 @@ const file_ad_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\x03R\tupdatedAt\"x\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\vis_favorite\x18\f \x01(\bR\n" +
+	"isFavorite\x12.\n" +
+	"\x13seller_review_count\x18\r \x01(\x05R\x11sellerReviewCount\x12'\n" +
+	"\x0ftitle_highlight\x18\x0e \x01(\tR\x0etitleHighlight\x123\n" +
+	"\x15description_highlight\x18\x0f \x01(\tR\x14descriptionHighlight\x12$\n" +
+	"\x06status\x18\x10 \x01(\x0e2\f.ad.AdStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x11 \x01(\x03R\texpiresAt\x12#\n" +
+	"\x06images\x18\x12 \x03(\v2\v.ad.AdImageR\x06images\x12(\n" +
+	"\blocation\x18\x13 \x01(\v2\f.ad.LocationR\blocation\x12$\n" +
+	"\vdistance_km\x18\x14 \x01(\x01H\x00R\n" +
+	"distanceKm\x88\x01\x01\x126\n" +
+	"\n" +
+	"attributes\x18\x15 \x03(\v2\x16.ad.Ad.AttributesEntryR\n" +
+	"attributes\x12\x1a\n" +
+	"\bpromoted\x18\x16 \x01(\bR\bpromoted\x12%\n" +
+	"\x0epromoted_until\x18\x17 \x01(\x03R\rpromotedUntil\x12\x1b\n" +
+	"\tbumped_at\x18\x18 \x01(\x03R\bbumpedAt\x12\x16\n" +
+	"\x06hidden\x18\x19 \x01(\bR\x06hidden\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_distance_km\"t\n" +
+	"\bLocation\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x15\n" +
+	"\x03lat\x18\x03 \x01(\x01H\x00R\x03lat\x88\x01\x01\x12\x15\n" +
+	"\x03lon\x18\x04 \x01(\x01H\x01R\x03lon\x88\x01\x01B\x06\n" +
+	"\x04_latB\x06\n" +
+	"\x04_lon\"L\n" +
+	"\tGeoFilter\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\x12\x1b\n" +
+	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\"\x9f\x01\n" +
+	"\fAttributeDef\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\"\x89\x01\n" +
+	"\x0fAttributeFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\x06equals\x18\x02 \x01(\tH\x00R\x06equals\x88\x01\x01\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x01R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x02R\x03max\x88\x01\x01B\t\n" +
+	"\a_equalsB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\x85\x01\n" +
+	"\x0fAttributeValues\x127\n" +
+	"\x06values\x18\x01 \x03(\v2\x1f.ad.AttributeValues.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
 	"\x0fCreateAdRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x03R\x05price\"*\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12(\n" +
+	"\blocation\x18\x06 \x01(\v2\f.ad.LocationR\blocation\x12C\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v2#.ad.CreateAdRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"*\n" +
 	"\x10CreateAdResponse\x12\x16\n" +
-	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"\x1e\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"X\n" +
 	"\fGetAdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\"'\n" +
 	"\rGetAdResponse\x12\x16\n" +
-	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"\xce\x01\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"\xea\x04\n" +
 	"\x0eListAdsRequest\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\tR\n" +
-	"categoryId\x12\x1b\n" +
-	"\tprice_min\x18\x03 \x01(\x03R\bpriceMin\x12\x1b\n" +
-	"\tprice_max\x18\x04 \x01(\x03R\bpriceMax\x12\x1c\n" +
+	"categoryId\x12 \n" +
+	"\tprice_min\x18\x03 \x01(\x03H\x00R\bpriceMin\x88\x01\x01\x12 \n" +
+	"\tprice_max\x18\x04 \x01(\x03H\x01R\bpriceMax\x88\x01\x01\x12\x1c\n" +
 	"\tcondition\x18\x05 \x01(\tR\tcondition\x12\x12\n" +
 	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\a \x01(\x05R\bpageSize\"r\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tviewer_id\x18\b \x01(\tR\bviewerId\x123\n" +
+	"\x15include_subcategories\x18\t \x01(\bR\x14includeSubcategories\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\x12$\n" +
+	"\x06status\x18\f \x01(\x0e2\f.ad.AdStatusR\x06status\x12/\n" +
+	"\x11min_seller_rating\x18\r \x01(\x01H\x02R\x0fminSellerRating\x88\x01\x01\x12\x12\n" +
+	"\x04city\x18\x0e \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x0f \x01(\tR\x06region\x12!\n" +
+	"\x04near\x18\x10 \x01(\v2\r.ad.GeoFilterR\x04near\x123\n" +
+	"\n" +
+	"attributes\x18\x11 \x03(\v2\x13.ad.AttributeFilterR\n" +
+	"attributesB\f\n" +
+	"\n" +
+	"_price_minB\f\n" +
+	"\n" +
+	"_price_maxB\x14\n" +
+	"\x12_min_seller_rating\"\xc3\x01\n" +
 	"\x0fListAdsResponse\x12\x18\n" +
 	"\x03ads\x18\x01 \x03(\v2\x06.ad.AdR\x03ads\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x97\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\x12'\n" +
+	"\x0ftotal_estimated\x18\x06 \x01(\bR\x0etotalEstimated\"\xc8\x01\n" +
+	"\x16ListAdsByAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12$\n" +
+	"\x06status\x18\x03 \x01(\x0e2\f.ad.AdStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xec\x03\n" +
 	"\x0fUpdateAdRequest\x12\x13\n" +
 	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
@@ -1177,8 +7220,13 @@ const file_ad_proto_rawDesc = "" +
 	"\x05price\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x05price\x12=\n" +
 	"\vcategory_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
 	"categoryId\x12:\n" +
-	"\tcondition\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tcondition\x124\n" +
-	"\x06status\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\x06status\"\x12\n" +
+	"\tcondition\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tcondition\x12$\n" +
+	"\x06status\x18\t \x01(\x0e2\f.ad.AdStatusR\x06status\x12(\n" +
+	"\blocation\x18\n" +
+	" \x01(\v2\f.ad.LocationR\blocation\x123\n" +
+	"\n" +
+	"attributes\x18\v \x01(\v2\x13.ad.AttributeValuesR\n" +
+	"attributesJ\x04\b\b\x10\t\"\x12\n" +
 	"\x10UpdateAdResponse\"?\n" +
 	"\x0fDeleteAdRequest\x12\x13\n" +
 	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
@@ -1196,25 +7244,472 @@ const file_ad_proto_rawDesc = "" +
 	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tmedia_ids\x18\x03 \x03(\tR\bmediaIds\"\x17\n" +
-	"\x15ReplaceImagesResponse\"\x9f\x01\n" +
+	"\x15ReplaceImagesResponse\"a\n" +
+	"\x14ReorderImagesRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\timage_ids\x18\x03 \x03(\tR\bimageIds\"<\n" +
+	"\x15ReorderImagesResponse\x12#\n" +
+	"\x06images\x18\x01 \x03(\v2\v.ad.AdImageR\x06images\"a\n" +
+	"\x16SetPrimaryImageRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bimage_id\x18\x03 \x01(\tR\aimageId\">\n" +
+	"\x17SetPrimaryImageResponse\x12#\n" +
+	"\x06images\x18\x01 \x03(\v2\v.ad.AdImageR\x06images\"\xf8\x02\n" +
 	"\x19CreateAdWithImagesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1b\n" +
-	"\tmedia_ids\x18\x05 \x03(\tR\bmediaIds\"4\n" +
+	"\tmedia_ids\x18\x05 \x03(\tR\bmediaIds\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12(\n" +
+	"\blocation\x18\a \x01(\v2\f.ad.LocationR\blocation\x12M\n" +
+	"\n" +
+	"attributes\x18\b \x03(\v2-.ad.CreateAdWithImagesRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
 	"\x1aCreateAdWithImagesResponse\x12\x16\n" +
-	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad2\xad\x04\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"B\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\"\x15\n" +
+	"\x13AddFavoriteResponse\"E\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\"\x18\n" +
+	"\x16RemoveFavoriteResponse\"`\n" +
+	"\x14ListFavoritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"x\n" +
+	"\x15ListFavoritesResponse\x12\x18\n" +
+	"\x03ads\x18\x01 \x03(\v2\x06.ad.AdR\x03ads\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9f\x01\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"u\n" +
+	"\x13CreateReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\":\n" +
+	"\x14CreateReviewResponse\x12\"\n" +
+	"\x06review\x18\x01 \x01(\v2\n" +
+	".ad.ReviewR\x06review\"K\n" +
+	"\x13DeleteReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\"\x16\n" +
+	"\x14DeleteReviewResponse\"\\\n" +
+	"\x14ListAdReviewsRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"h\n" +
+	"\x18ListSellerReviewsRequest\x12\x1b\n" +
+	"\tseller_id\x18\x01 \x01(\tR\bsellerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x82\x01\n" +
+	"\x13ListReviewsResponse\x12$\n" +
+	"\areviews\x18\x01 \x03(\v2\n" +
+	".ad.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xcf\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\x12(\n" +
+	"\bchildren\x18\x06 \x03(\v2\f.ad.CategoryR\bchildren\x12(\n" +
+	"\x10ad_lifetime_days\x18\a \x01(\x05R\x0eadLifetimeDays\"B\n" +
+	"\x15ListCategoriesRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"F\n" +
+	"\x16ListCategoriesResponse\x12,\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\f.ad.CategoryR\n" +
+	"categories\"\x9f\x01\n" +
+	"\x15CreateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12(\n" +
+	"\x10ad_lifetime_days\x18\x05 \x01(\x05R\x0eadLifetimeDays\"B\n" +
+	"\x16CreateCategoryResponse\x12(\n" +
+	"\bcategory\x18\x01 \x01(\v2\f.ad.CategoryR\bcategory\"\xa6\x02\n" +
+	"\x15UpdateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x120\n" +
+	"\x04slug\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04slug\x120\n" +
+	"\x04name\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x129\n" +
+	"\tparent_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bparentId\x12E\n" +
+	"\x10ad_lifetime_days\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0eadLifetimeDays\"B\n" +
+	"\x16UpdateCategoryResponse\x12(\n" +
+	"\bcategory\x18\x01 \x01(\v2\f.ad.CategoryR\bcategory\"A\n" +
+	"\x16ArchiveCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x19\n" +
+	"\x17ArchiveCategoryResponse\"?\n" +
+	"\x1cGetCategoryAttributesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"Q\n" +
+	"\x1dGetCategoryAttributesResponse\x120\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x10.ad.AttributeDefR\n" +
+	"attributes\"\x8a\x01\n" +
+	"\x1cSetCategoryAttributesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x120\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2\x10.ad.AttributeDefR\n" +
+	"attributes\"Q\n" +
+	"\x1dSetCategoryAttributesResponse\x120\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x10.ad.AttributeDefR\n" +
+	"attributes\">\n" +
+	"\x0eRenewAdRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\")\n" +
+	"\x0fRenewAdResponse\x12\x16\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"@\n" +
+	"\x10RestoreAdRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"+\n" +
+	"\x11RestoreAdResponse\x12\x16\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xb2\x01\n" +
+	"\n" +
+	"AdRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12)\n" +
+	"\achanges\x18\x05 \x03(\v2\x0f.ad.FieldChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"w\n" +
+	"\x16ListAdRevisionsRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x8e\x01\n" +
+	"\x17ListAdRevisionsResponse\x12,\n" +
+	"\trevisions\x18\x01 \x03(\v2\x0e.ad.AdRevisionR\trevisions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"`\n" +
+	"\x14GetAdRevisionRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\"C\n" +
+	"\x15GetAdRevisionResponse\x12*\n" +
+	"\brevision\x18\x01 \x01(\v2\x0e.ad.AdRevisionR\brevision\"A\n" +
+	"\n" +
+	"PricePoint\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x03R\x05price\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x02 \x01(\x03R\tchangedAt\"J\n" +
+	"\x16GetPriceHistoryRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"A\n" +
+	"\x17GetPriceHistoryResponse\x12&\n" +
+	"\x06points\x18\x01 \x03(\v2\x0e.ad.PricePointR\x06points\"\x9a\x03\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x123\n" +
+	"\x15include_subcategories\x18\x05 \x01(\bR\x14includeSubcategories\x12 \n" +
+	"\tprice_min\x18\x06 \x01(\x03H\x00R\bpriceMin\x88\x01\x01\x12 \n" +
+	"\tprice_max\x18\a \x01(\x03H\x01R\bpriceMax\x88\x01\x01\x12\x1c\n" +
+	"\tcondition\x18\b \x01(\tR\tcondition\x12\x1f\n" +
+	"\vnew_matches\x18\t \x01(\x05R\n" +
+	"newMatches\x12 \n" +
+	"\flast_seen_at\x18\n" +
+	" \x01(\x03R\n" +
+	"lastSeenAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAtB\f\n" +
+	"\n" +
+	"_price_minB\f\n" +
+	"\n" +
+	"_price_max\"\xaf\x02\n" +
+	"\x18CreateSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x123\n" +
+	"\x15include_subcategories\x18\x05 \x01(\bR\x14includeSubcategories\x12 \n" +
+	"\tprice_min\x18\x06 \x01(\x03H\x00R\bpriceMin\x88\x01\x01\x12 \n" +
+	"\tprice_max\x18\a \x01(\x03H\x01R\bpriceMax\x88\x01\x01\x12\x1c\n" +
+	"\tcondition\x18\b \x01(\tR\tconditionB\f\n" +
+	"\n" +
+	"_price_minB\f\n" +
+	"\n" +
+	"_price_max\"O\n" +
+	"\x19CreateSavedSearchResponse\x122\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x0f.ad.SavedSearchR\vsavedSearch\"\xbf\x02\n" +
+	"\x18UpdateSavedSearchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x123\n" +
+	"\x15include_subcategories\x18\x06 \x01(\bR\x14includeSubcategories\x12 \n" +
+	"\tprice_min\x18\a \x01(\x03H\x00R\bpriceMin\x88\x01\x01\x12 \n" +
+	"\tprice_max\x18\b \x01(\x03H\x01R\bpriceMax\x88\x01\x01\x12\x1c\n" +
+	"\tcondition\x18\t \x01(\tR\tconditionB\f\n" +
+	"\n" +
+	"_price_minB\f\n" +
+	"\n" +
+	"_price_max\"O\n" +
+	"\x19UpdateSavedSearchResponse\x122\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x0f.ad.SavedSearchR\vsavedSearch\"C\n" +
+	"\x18DeleteSavedSearchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19DeleteSavedSearchResponse\"3\n" +
+	"\x18ListSavedSearchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x7f\n" +
+	"\x19ListSavedSearchesResponse\x126\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x0f.ad.SavedSearchR\rsavedSearches\x12*\n" +
+	"\x11total_new_matches\x18\x02 \x01(\x05R\x0ftotalNewMatches\"\x96\x01\n" +
+	"\x1dListSavedSearchMatchesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tmark_seen\x18\x05 \x01(\bR\bmarkSeen\"\x81\x01\n" +
+	"\x1eListSavedSearchMatchesResponse\x12\x18\n" +
+	"\x03ads\x18\x01 \x03(\v2\x06.ad.AdR\x03ads\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"}\n" +
+	"\fAdDailyStats\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x05R\x05views\x12\x1c\n" +
+	"\tfavorites\x18\x03 \x01(\x05R\tfavorites\x12'\n" +
+	"\x0fcontact_reveals\x18\x04 \x01(\x05R\x0econtactReveals\"U\n" +
+	"\x11GetAdStatsRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"\xb8\x01\n" +
+	"\x12GetAdStatsResponse\x12$\n" +
+	"\x04days\x18\x01 \x03(\v2\x10.ad.AdDailyStatsR\x04days\x12\x1f\n" +
+	"\vtotal_views\x18\x02 \x01(\x05R\n" +
+	"totalViews\x12'\n" +
+	"\x0ftotal_favorites\x18\x03 \x01(\x05R\x0etotalFavorites\x122\n" +
+	"\x15total_contact_reveals\x18\x04 \x01(\x05R\x13totalContactReveals\"\xbe\x01\n" +
+	"\x0eAdStatsSummary\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12$\n" +
+	"\x06status\x18\x03 \x01(\x0e2\f.ad.AdStatusR\x06status\x12\x14\n" +
+	"\x05views\x18\x04 \x01(\x05R\x05views\x12\x1c\n" +
+	"\tfavorites\x18\x05 \x01(\x05R\tfavorites\x12'\n" +
+	"\x0fcontact_reveals\x18\x06 \x01(\x05R\x0econtactReveals\"u\n" +
+	"\x15GetSellerStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x85\x01\n" +
+	"\x16GetSellerStatsResponse\x12$\n" +
+	"\x03ads\x18\x01 \x03(\v2\x12.ad.AdStatsSummaryR\x03ads\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"J\n" +
+	"\x1aRecordContactRevealRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\":\n" +
+	"\x1bRecordContactRevealResponse\x12\x1b\n" +
+	"\tseller_id\x18\x01 \x01(\tR\bsellerId\"T\n" +
+	"\x10PromoteAdRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"E\n" +
+	"\x11PromoteAdResponse\x12\x16\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\"=\n" +
+	"\rBumpAdRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"(\n" +
+	"\x0eBumpAdResponse\x12\x16\n" +
+	"\x02ad\x18\x01 \x01(\v2\x06.ad.AdR\x02ad\"\xc0\x01\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x13\n" +
+	"\x05ad_id\x18\x04 \x01(\tR\x04adId\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\x03R\fbalanceAfter\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"]\n" +
+	"\x11GetBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa0\x01\n" +
+	"\x12GetBalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x03R\abalance\x12)\n" +
+	"\aentries\x18\x02 \x03(\v2\x0f.ad.LedgerEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x87\x01\n" +
+	"\x14CreditBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\">\n" +
+	"\x15CreditBalanceResponse\x12%\n" +
+	"\x05entry\x18\x01 \x01(\v2\x0f.ad.LedgerEntryR\x05entry\"\xab\x02\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x1f\n" +
+	"\vreporter_id\x18\x03 \x01(\tR\n" +
+	"reporterId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12(\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x10.ad.ReportStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\a \x01(\tR\n" +
+	"resolution\x12\x1f\n" +
+	"\vreviewer_id\x18\b \x01(\tR\n" +
+	"reviewerId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\x03R\n" +
+	"resolvedAt\"q\n" +
+	"\x0fReportAdRequest\x12\x13\n" +
+	"\x05ad_id\x18\x01 \x01(\tR\x04adId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"6\n" +
+	"\x10ReportAdResponse\x12\"\n" +
+	"\x06report\x18\x01 \x01(\v2\n" +
+	".ad.ReportR\x06report\"\x9d\x01\n" +
+	"\x12ListReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.ad.ReportStatusR\x06status\x12\x13\n" +
+	"\x05ad_id\x18\x03 \x01(\tR\x04adId\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x82\x01\n" +
+	"\x13ListReportsResponse\x12$\n" +
+	"\areports\x18\x01 \x03(\v2\n" +
+	".ad.ReportR\areports\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"[\n" +
+	"\x11ModerateAdRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x14\n" +
+	"\x12ModerateAdResponse\"e\n" +
+	"\x13RejectReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\tR\breportId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\":\n" +
+	"\x14RejectReportResponse\x12\"\n" +
+	"\x06report\x18\x01 \x01(\v2\n" +
+	".ad.ReportR\x06report\"\xc0\x01\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x1b\n" +
+	"\treport_id\x18\x03 \x01(\tR\breportId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"y\n" +
+	"\x18ListModerationLogRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x05ad_id\x18\x02 \x01(\tR\x04adId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x92\x01\n" +
+	"\x19ListModerationLogResponse\x12.\n" +
+	"\aactions\x18\x01 \x03(\v2\x14.ad.ModerationActionR\aactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*\x7f\n" +
+	"\bAdStatus\x12\x19\n" +
+	"\x15AD_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10AD_STATUS_ACTIVE\x10\x01\x12\x16\n" +
+	"\x12AD_STATUS_INACTIVE\x10\x02\x12\x12\n" +
+	"\x0eAD_STATUS_SOLD\x10\x03\x12\x16\n" +
+	"\x12AD_STATUS_ARCHIVED\x10\x04*~\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1b\n" +
+	"\x17REPORT_STATUS_IN_REVIEW\x10\x02\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x032\x8c\x1a\n" +
 	"\tAdService\x125\n" +
 	"\bCreateAd\x12\x13.ad.CreateAdRequest\x1a\x14.ad.CreateAdResponse\x12,\n" +
 	"\x05GetAd\x12\x10.ad.GetAdRequest\x1a\x11.ad.GetAdResponse\x122\n" +
-	"\aListAds\x12\x12.ad.ListAdsRequest\x1a\x13.ad.ListAdsResponse\x125\n" +
+	"\aListAds\x12\x12.ad.ListAdsRequest\x1a\x13.ad.ListAdsResponse\x12B\n" +
+	"\x0fListAdsByAuthor\x12\x1a.ad.ListAdsByAuthorRequest\x1a\x13.ad.ListAdsResponse\x125\n" +
 	"\bUpdateAd\x12\x13.ad.UpdateAdRequest\x1a\x14.ad.UpdateAdResponse\x125\n" +
 	"\bDeleteAd\x12\x13.ad.DeleteAdRequest\x1a\x14.ad.DeleteAdResponse\x12>\n" +
 	"\vAttachMedia\x12\x16.ad.AttachMediaRequest\x1a\x17.ad.AttachMediaResponse\x12>\n" +
 	"\vDetachMedia\x12\x16.ad.DetachMediaRequest\x1a\x17.ad.DetachMediaResponse\x12D\n" +
-	"\rReplaceImages\x12\x18.ad.ReplaceImagesRequest\x1a\x19.ad.ReplaceImagesResponse\x12S\n" +
-	"\x12CreateAdWithImages\x12\x1d.ad.CreateAdWithImagesRequest\x1a\x1e.ad.CreateAdWithImagesResponseB0Z.78-pflops/services/ad_service/pb/ad_service/pbb\x06proto3"
+	"\rReplaceImages\x12\x18.ad.ReplaceImagesRequest\x1a\x19.ad.ReplaceImagesResponse\x12D\n" +
+	"\rReorderImages\x12\x18.ad.ReorderImagesRequest\x1a\x19.ad.ReorderImagesResponse\x12J\n" +
+	"\x0fSetPrimaryImage\x12\x1a.ad.SetPrimaryImageRequest\x1a\x1b.ad.SetPrimaryImageResponse\x12S\n" +
+	"\x12CreateAdWithImages\x12\x1d.ad.CreateAdWithImagesRequest\x1a\x1e.ad.CreateAdWithImagesResponse\x12>\n" +
+	"\vAddFavorite\x12\x16.ad.AddFavoriteRequest\x1a\x17.ad.AddFavoriteResponse\x12G\n" +
+	"\x0eRemoveFavorite\x12\x19.ad.RemoveFavoriteRequest\x1a\x1a.ad.RemoveFavoriteResponse\x12D\n" +
+	"\rListFavorites\x12\x18.ad.ListFavoritesRequest\x1a\x19.ad.ListFavoritesResponse\x12A\n" +
+	"\fCreateReview\x12\x17.ad.CreateReviewRequest\x1a\x18.ad.CreateReviewResponse\x12A\n" +
+	"\fDeleteReview\x12\x17.ad.DeleteReviewRequest\x1a\x18.ad.DeleteReviewResponse\x12B\n" +
+	"\rListAdReviews\x12\x18.ad.ListAdReviewsRequest\x1a\x17.ad.ListReviewsResponse\x12J\n" +
+	"\x11ListSellerReviews\x12\x1c.ad.ListSellerReviewsRequest\x1a\x17.ad.ListReviewsResponse\x12G\n" +
+	"\x0eListCategories\x12\x19.ad.ListCategoriesRequest\x1a\x1a.ad.ListCategoriesResponse\x12G\n" +
+	"\x0eCreateCategory\x12\x19.ad.CreateCategoryRequest\x1a\x1a.ad.CreateCategoryResponse\x12G\n" +
+	"\x0eUpdateCategory\x12\x19.ad.UpdateCategoryRequest\x1a\x1a.ad.UpdateCategoryResponse\x12J\n" +
+	"\x0fArchiveCategory\x12\x1a.ad.ArchiveCategoryRequest\x1a\x1b.ad.ArchiveCategoryResponse\x12\\\n" +
+	"\x15GetCategoryAttributes\x12 .ad.GetCategoryAttributesRequest\x1a!.ad.GetCategoryAttributesResponse\x12\\\n" +
+	"\x15SetCategoryAttributes\x12 .ad.SetCategoryAttributesRequest\x1a!.ad.SetCategoryAttributesResponse\x122\n" +
+	"\aRenewAd\x12\x12.ad.RenewAdRequest\x1a\x13.ad.RenewAdResponse\x128\n" +
+	"\tRestoreAd\x12\x14.ad.RestoreAdRequest\x1a\x15.ad.RestoreAdResponse\x12J\n" +
+	"\x0fListAdRevisions\x12\x1a.ad.ListAdRevisionsRequest\x1a\x1b.ad.ListAdRevisionsResponse\x12D\n" +
+	"\rGetAdRevision\x12\x18.ad.GetAdRevisionRequest\x1a\x19.ad.GetAdRevisionResponse\x12J\n" +
+	"\x0fGetPriceHistory\x12\x1a.ad.GetPriceHistoryRequest\x1a\x1b.ad.GetPriceHistoryResponse\x12;\n" +
+	"\n" +
+	"GetAdStats\x12\x15.ad.GetAdStatsRequest\x1a\x16.ad.GetAdStatsResponse\x12G\n" +
+	"\x0eGetSellerStats\x12\x19.ad.GetSellerStatsRequest\x1a\x1a.ad.GetSellerStatsResponse\x12V\n" +
+	"\x13RecordContactReveal\x12\x1e.ad.RecordContactRevealRequest\x1a\x1f.ad.RecordContactRevealResponse\x128\n" +
+	"\tPromoteAd\x12\x14.ad.PromoteAdRequest\x1a\x15.ad.PromoteAdResponse\x12/\n" +
+	"\x06BumpAd\x12\x11.ad.BumpAdRequest\x1a\x12.ad.BumpAdResponse\x12;\n" +
+	"\n" +
+	"GetBalance\x12\x15.ad.GetBalanceRequest\x1a\x16.ad.GetBalanceResponse\x12D\n" +
+	"\rCreditBalance\x12\x18.ad.CreditBalanceRequest\x1a\x19.ad.CreditBalanceResponse\x125\n" +
+	"\bReportAd\x12\x13.ad.ReportAdRequest\x1a\x14.ad.ReportAdResponse\x12>\n" +
+	"\vListReports\x12\x16.ad.ListReportsRequest\x1a\x17.ad.ListReportsResponse\x127\n" +
+	"\x06HideAd\x12\x15.ad.ModerateAdRequest\x1a\x16.ad.ModerateAdResponse\x129\n" +
+	"\bUnhideAd\x12\x15.ad.ModerateAdRequest\x1a\x16.ad.ModerateAdResponse\x12A\n" +
+	"\fRejectReport\x12\x17.ad.RejectReportRequest\x1a\x18.ad.RejectReportResponse\x12P\n" +
+	"\x11ListModerationLog\x12\x1c.ad.ListModerationLogRequest\x1a\x1d.ad.ListModerationLogResponse\x12P\n" +
+	"\x11CreateSavedSearch\x12\x1c.ad.CreateSavedSearchRequest\x1a\x1d.ad.CreateSavedSearchResponse\x12P\n" +
+	"\x11UpdateSavedSearch\x12\x1c.ad.UpdateSavedSearchRequest\x1a\x1d.ad.UpdateSavedSearchResponse\x12P\n" +
+	"\x11DeleteSavedSearch\x12\x1c.ad.DeleteSavedSearchRequest\x1a\x1d.ad.DeleteSavedSearchResponse\x12P\n" +
+	"\x11ListSavedSearches\x12\x1c.ad.ListSavedSearchesRequest\x1a\x1d.ad.ListSavedSearchesResponse\x12_\n" +
+	"\x16ListSavedSearchMatches\x12!.ad.ListSavedSearchMatchesRequest\x1a\".ad.ListSavedSearchMatchesResponseB0Z.78-pflops/services/ad_service/pb/ad_service/pbb\x06proto3"
 
 var (
 	file_ad_proto_rawDescOnce sync.Once
@@ -1228,64 +7723,295 @@ func file_ad_proto_rawDescGZIP() []byte {
 	return file_ad_proto_rawDescData
 }
 
-var file_ad_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_ad_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ad_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_ad_proto_goTypes = []any{
-	(*Ad)(nil),                         // 0: ad.Ad
-	(*CreateAdRequest)(nil),            // 1: ad.CreateAdRequest
-	(*CreateAdResponse)(nil),           // 2: ad.CreateAdResponse
-	(*GetAdRequest)(nil),               // 3: ad.GetAdRequest
-	(*GetAdResponse)(nil),              // 4: ad.GetAdResponse
-	(*ListAdsRequest)(nil),             // 5: ad.ListAdsRequest
-	(*ListAdsResponse)(nil),            // 6: ad.ListAdsResponse
-	(*UpdateAdRequest)(nil),            // 7: ad.UpdateAdRequest
-	(*UpdateAdResponse)(nil),           // 8: ad.UpdateAdResponse
-	(*DeleteAdRequest)(nil),            // 9: ad.DeleteAdRequest
-	(*DeleteAdResponse)(nil),           // 10: ad.DeleteAdResponse
-	(*AttachMediaRequest)(nil),         // 11: ad.AttachMediaRequest
-	(*AttachMediaResponse)(nil),        // 12: ad.AttachMediaResponse
-	(*DetachMediaRequest)(nil),         // 13: ad.DetachMediaRequest
-	(*DetachMediaResponse)(nil),        // 14: ad.DetachMediaResponse
-	(*ReplaceImagesRequest)(nil),       // 15: ad.ReplaceImagesRequest
-	(*ReplaceImagesResponse)(nil),      // 16: ad.ReplaceImagesResponse
-	(*CreateAdWithImagesRequest)(nil),  // 17: ad.CreateAdWithImagesRequest
-	(*CreateAdWithImagesResponse)(nil), // 18: ad.CreateAdWithImagesResponse
-	(*wrapperspb.StringValue)(nil),     // 19: google.protobuf.StringValue
-	(*wrapperspb.Int64Value)(nil),      // 20: google.protobuf.Int64Value
+	(AdStatus)(0),                          // 0: ad.AdStatus
+	(ReportStatus)(0),                      // 1: ad.ReportStatus
+	(*AdImage)(nil),                        // 2: ad.AdImage
+	(*Ad)(nil),                             // 3: ad.Ad
+	(*Location)(nil),                       // 4: ad.Location
+	(*GeoFilter)(nil),                      // 5: ad.GeoFilter
+	(*AttributeDef)(nil),                   // 6: ad.AttributeDef
+	(*AttributeFilter)(nil),                // 7: ad.AttributeFilter
+	(*AttributeValues)(nil),                // 8: ad.AttributeValues
+	(*CreateAdRequest)(nil),                // 9: ad.CreateAdRequest
+	(*CreateAdResponse)(nil),               // 10: ad.CreateAdResponse
+	(*GetAdRequest)(nil),                   // 11: ad.GetAdRequest
+	(*GetAdResponse)(nil),                  // 12: ad.GetAdResponse
+	(*ListAdsRequest)(nil),                 // 13: ad.ListAdsRequest
+	(*ListAdsResponse)(nil),                // 14: ad.ListAdsResponse
+	(*ListAdsByAuthorRequest)(nil),         // 15: ad.ListAdsByAuthorRequest
+	(*UpdateAdRequest)(nil),                // 16: ad.UpdateAdRequest
+	(*UpdateAdResponse)(nil),               // 17: ad.UpdateAdResponse
+	(*DeleteAdRequest)(nil),                // 18: ad.DeleteAdRequest
+	(*DeleteAdResponse)(nil),               // 19: ad.DeleteAdResponse
+	(*AttachMediaRequest)(nil),             // 20: ad.AttachMediaRequest
+	(*AttachMediaResponse)(nil),            // 21: ad.AttachMediaResponse
+	(*DetachMediaRequest)(nil),             // 22: ad.DetachMediaRequest
+	(*DetachMediaResponse)(nil),            // 23: ad.DetachMediaResponse
+	(*ReplaceImagesRequest)(nil),           // 24: ad.ReplaceImagesRequest
+	(*ReplaceImagesResponse)(nil),          // 25: ad.ReplaceImagesResponse
+	(*ReorderImagesRequest)(nil),           // 26: ad.ReorderImagesRequest
+	(*ReorderImagesResponse)(nil),          // 27: ad.ReorderImagesResponse
+	(*SetPrimaryImageRequest)(nil),         // 28: ad.SetPrimaryImageRequest
+	(*SetPrimaryImageResponse)(nil),        // 29: ad.SetPrimaryImageResponse
+	(*CreateAdWithImagesRequest)(nil),      // 30: ad.CreateAdWithImagesRequest
+	(*CreateAdWithImagesResponse)(nil),     // 31: ad.CreateAdWithImagesResponse
+	(*AddFavoriteRequest)(nil),             // 32: ad.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),            // 33: ad.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),          // 34: ad.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),         // 35: ad.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),           // 36: ad.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),          // 37: ad.ListFavoritesResponse
+	(*Review)(nil),                         // 38: ad.Review
+	(*CreateReviewRequest)(nil),            // 39: ad.CreateReviewRequest
+	(*CreateReviewResponse)(nil),           // 40: ad.CreateReviewResponse
+	(*DeleteReviewRequest)(nil),            // 41: ad.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),           // 42: ad.DeleteReviewResponse
+	(*ListAdReviewsRequest)(nil),           // 43: ad.ListAdReviewsRequest
+	(*ListSellerReviewsRequest)(nil),       // 44: ad.ListSellerReviewsRequest
+	(*ListReviewsResponse)(nil),            // 45: ad.ListReviewsResponse
+	(*Category)(nil),                       // 46: ad.Category
+	(*ListCategoriesRequest)(nil),          // 47: ad.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),         // 48: ad.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),          // 49: ad.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),         // 50: ad.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),          // 51: ad.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),         // 52: ad.UpdateCategoryResponse
+	(*ArchiveCategoryRequest)(nil),         // 53: ad.ArchiveCategoryRequest
+	(*ArchiveCategoryResponse)(nil),        // 54: ad.ArchiveCategoryResponse
+	(*GetCategoryAttributesRequest)(nil),   // 55: ad.GetCategoryAttributesRequest
+	(*GetCategoryAttributesResponse)(nil),  // 56: ad.GetCategoryAttributesResponse
+	(*SetCategoryAttributesRequest)(nil),   // 57: ad.SetCategoryAttributesRequest
+	(*SetCategoryAttributesResponse)(nil),  // 58: ad.SetCategoryAttributesResponse
+	(*RenewAdRequest)(nil),                 // 59: ad.RenewAdRequest
+	(*RenewAdResponse)(nil),                // 60: ad.RenewAdResponse
+	(*RestoreAdRequest)(nil),               // 61: ad.RestoreAdRequest
+	(*RestoreAdResponse)(nil),              // 62: ad.RestoreAdResponse
+	(*FieldChange)(nil),                    // 63: ad.FieldChange
+	(*AdRevision)(nil),                     // 64: ad.AdRevision
+	(*ListAdRevisionsRequest)(nil),         // 65: ad.ListAdRevisionsRequest
+	(*ListAdRevisionsResponse)(nil),        // 66: ad.ListAdRevisionsResponse
+	(*GetAdRevisionRequest)(nil),           // 67: ad.GetAdRevisionRequest
+	(*GetAdRevisionResponse)(nil),          // 68: ad.GetAdRevisionResponse
+	(*PricePoint)(nil),                     // 69: ad.PricePoint
+	(*GetPriceHistoryRequest)(nil),         // 70: ad.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),        // 71: ad.GetPriceHistoryResponse
+	(*SavedSearch)(nil),                    // 72: ad.SavedSearch
+	(*CreateSavedSearchRequest)(nil),       // 73: ad.CreateSavedSearchRequest
+	(*CreateSavedSearchResponse)(nil),      // 74: ad.CreateSavedSearchResponse
+	(*UpdateSavedSearchRequest)(nil),       // 75: ad.UpdateSavedSearchRequest
+	(*UpdateSavedSearchResponse)(nil),      // 76: ad.UpdateSavedSearchResponse
+	(*DeleteSavedSearchRequest)(nil),       // 77: ad.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),      // 78: ad.DeleteSavedSearchResponse
+	(*ListSavedSearchesRequest)(nil),       // 79: ad.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),      // 80: ad.ListSavedSearchesResponse
+	(*ListSavedSearchMatchesRequest)(nil),  // 81: ad.ListSavedSearchMatchesRequest
+	(*ListSavedSearchMatchesResponse)(nil), // 82: ad.ListSavedSearchMatchesResponse
+	(*AdDailyStats)(nil),                   // 83: ad.AdDailyStats
+	(*GetAdStatsRequest)(nil),              // 84: ad.GetAdStatsRequest
+	(*GetAdStatsResponse)(nil),             // 85: ad.GetAdStatsResponse
+	(*AdStatsSummary)(nil),                 // 86: ad.AdStatsSummary
+	(*GetSellerStatsRequest)(nil),          // 87: ad.GetSellerStatsRequest
+	(*GetSellerStatsResponse)(nil),         // 88: ad.GetSellerStatsResponse
+	(*RecordContactRevealRequest)(nil),     // 89: ad.RecordContactRevealRequest
+	(*RecordContactRevealResponse)(nil),    // 90: ad.RecordContactRevealResponse
+	(*PromoteAdRequest)(nil),               // 91: ad.PromoteAdRequest
+	(*PromoteAdResponse)(nil),              // 92: ad.PromoteAdResponse
+	(*BumpAdRequest)(nil),                  // 93: ad.BumpAdRequest
+	(*BumpAdResponse)(nil),                 // 94: ad.BumpAdResponse
+	(*LedgerEntry)(nil),                    // 95: ad.LedgerEntry
+	(*GetBalanceRequest)(nil),              // 96: ad.GetBalanceRequest
+	(*GetBalanceResponse)(nil),             // 97: ad.GetBalanceResponse
+	(*CreditBalanceRequest)(nil),           // 98: ad.CreditBalanceRequest
+	(*CreditBalanceResponse)(nil),          // 99: ad.CreditBalanceResponse
+	(*Report)(nil),                         // 100: ad.Report
+	(*ReportAdRequest)(nil),                // 101: ad.ReportAdRequest
+	(*ReportAdResponse)(nil),               // 102: ad.ReportAdResponse
+	(*ListReportsRequest)(nil),             // 103: ad.ListReportsRequest
+	(*ListReportsResponse)(nil),            // 104: ad.ListReportsResponse
+	(*ModerateAdRequest)(nil),              // 105: ad.ModerateAdRequest
+	(*ModerateAdResponse)(nil),             // 106: ad.ModerateAdResponse
+	(*RejectReportRequest)(nil),            // 107: ad.RejectReportRequest
+	(*RejectReportResponse)(nil),           // 108: ad.RejectReportResponse
+	(*ModerationAction)(nil),               // 109: ad.ModerationAction
+	(*ListModerationLogRequest)(nil),       // 110: ad.ListModerationLogRequest
+	(*ListModerationLogResponse)(nil),      // 111: ad.ListModerationLogResponse
+	nil,                                    // 112: ad.Ad.AttributesEntry
+	nil,                                    // 113: ad.AttributeValues.ValuesEntry
+	nil,                                    // 114: ad.CreateAdRequest.AttributesEntry
+	nil,                                    // 115: ad.CreateAdWithImagesRequest.AttributesEntry
+	(*wrapperspb.StringValue)(nil),         // 116: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),          // 117: google.protobuf.Int64Value
+	(*wrapperspb.Int32Value)(nil),          // 118: google.protobuf.Int32Value
 }
 var file_ad_proto_depIdxs = []int32{
-	0,  // 0: ad.CreateAdResponse.ad:type_name -> ad.Ad
-	0,  // 1: ad.GetAdResponse.ad:type_name -> ad.Ad
-	0,  // 2: ad.ListAdsResponse.ads:type_name -> ad.Ad
-	19, // 3: ad.UpdateAdRequest.title:type_name -> google.protobuf.StringValue
-	19, // 4: ad.UpdateAdRequest.description:type_name -> google.protobuf.StringValue
-	20, // 5: ad.UpdateAdRequest.price:type_name -> google.protobuf.Int64Value
-	19, // 6: ad.UpdateAdRequest.category_id:type_name -> google.protobuf.StringValue
-	19, // 7: ad.UpdateAdRequest.condition:type_name -> google.protobuf.StringValue
-	19, // 8: ad.UpdateAdRequest.status:type_name -> google.protobuf.StringValue
-	0,  // 9: ad.CreateAdWithImagesResponse.ad:type_name -> ad.Ad
-	1,  // 10: ad.AdService.CreateAd:input_type -> ad.CreateAdRequest
-	3,  // 11: ad.AdService.GetAd:input_type -> ad.GetAdRequest
-	5,  // 12: ad.AdService.ListAds:input_type -> ad.ListAdsRequest
-	7,  // 13: ad.AdService.UpdateAd:input_type -> ad.UpdateAdRequest
-	9,  // 14: ad.AdService.DeleteAd:input_type -> ad.DeleteAdRequest
-	11, // 15: ad.AdService.AttachMedia:input_type -> ad.AttachMediaRequest
-	13, // 16: ad.AdService.DetachMedia:input_type -> ad.DetachMediaRequest
-	15, // 17: ad.AdService.ReplaceImages:input_type -> ad.ReplaceImagesRequest
-	17, // 18: ad.AdService.CreateAdWithImages:input_type -> ad.CreateAdWithImagesRequest
-	2,  // 19: ad.AdService.CreateAd:output_type -> ad.CreateAdResponse
-	4,  // 20: ad.AdService.GetAd:output_type -> ad.GetAdResponse
-	6,  // 21: ad.AdService.ListAds:output_type -> ad.ListAdsResponse
-	8,  // 22: ad.AdService.UpdateAd:output_type -> ad.UpdateAdResponse
-	10, // 23: ad.AdService.DeleteAd:output_type -> ad.DeleteAdResponse
-	12, // 24: ad.AdService.AttachMedia:output_type -> ad.AttachMediaResponse
-	14, // 25: ad.AdService.DetachMedia:output_type -> ad.DetachMediaResponse
-	16, // 26: ad.AdService.ReplaceImages:output_type -> ad.ReplaceImagesResponse
-	18, // 27: ad.AdService.CreateAdWithImages:output_type -> ad.CreateAdWithImagesResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	0,   // 0: ad.Ad.status:type_name -> ad.AdStatus
+	2,   // 1: ad.Ad.images:type_name -> ad.AdImage
+	4,   // 2: ad.Ad.location:type_name -> ad.Location
+	112, // 3: ad.Ad.attributes:type_name -> ad.Ad.AttributesEntry
+	113, // 4: ad.AttributeValues.values:type_name -> ad.AttributeValues.ValuesEntry
+	4,   // 5: ad.CreateAdRequest.location:type_name -> ad.Location
+	114, // 6: ad.CreateAdRequest.attributes:type_name -> ad.CreateAdRequest.AttributesEntry
+	3,   // 7: ad.CreateAdResponse.ad:type_name -> ad.Ad
+	3,   // 8: ad.GetAdResponse.ad:type_name -> ad.Ad
+	0,   // 9: ad.ListAdsRequest.status:type_name -> ad.AdStatus
+	5,   // 10: ad.ListAdsRequest.near:type_name -> ad.GeoFilter
+	7,   // 11: ad.ListAdsRequest.attributes:type_name -> ad.AttributeFilter
+	3,   // 12: ad.ListAdsResponse.ads:type_name -> ad.Ad
+	0,   // 13: ad.ListAdsByAuthorRequest.status:type_name -> ad.AdStatus
+	116, // 14: ad.UpdateAdRequest.title:type_name -> google.protobuf.StringValue
+	116, // 15: ad.UpdateAdRequest.description:type_name -> google.protobuf.StringValue
+	117, // 16: ad.UpdateAdRequest.price:type_name -> google.protobuf.Int64Value
+	116, // 17: ad.UpdateAdRequest.category_id:type_name -> google.protobuf.StringValue
+	116, // 18: ad.UpdateAdRequest.condition:type_name -> google.protobuf.StringValue
+	0,   // 19: ad.UpdateAdRequest.status:type_name -> ad.AdStatus
+	4,   // 20: ad.UpdateAdRequest.location:type_name -> ad.Location
+	8,   // 21: ad.UpdateAdRequest.attributes:type_name -> ad.AttributeValues
+	2,   // 22: ad.ReorderImagesResponse.images:type_name -> ad.AdImage
+	2,   // 23: ad.SetPrimaryImageResponse.images:type_name -> ad.AdImage
+	4,   // 24: ad.CreateAdWithImagesRequest.location:type_name -> ad.Location
+	115, // 25: ad.CreateAdWithImagesRequest.attributes:type_name -> ad.CreateAdWithImagesRequest.AttributesEntry
+	3,   // 26: ad.CreateAdWithImagesResponse.ad:type_name -> ad.Ad
+	3,   // 27: ad.ListFavoritesResponse.ads:type_name -> ad.Ad
+	38,  // 28: ad.CreateReviewResponse.review:type_name -> ad.Review
+	38,  // 29: ad.ListReviewsResponse.reviews:type_name -> ad.Review
+	46,  // 30: ad.Category.children:type_name -> ad.Category
+	46,  // 31: ad.ListCategoriesResponse.categories:type_name -> ad.Category
+	46,  // 32: ad.CreateCategoryResponse.category:type_name -> ad.Category
+	116, // 33: ad.UpdateCategoryRequest.slug:type_name -> google.protobuf.StringValue
+	116, // 34: ad.UpdateCategoryRequest.name:type_name -> google.protobuf.StringValue
+	116, // 35: ad.UpdateCategoryRequest.parent_id:type_name -> google.protobuf.StringValue
+	118, // 36: ad.UpdateCategoryRequest.ad_lifetime_days:type_name -> google.protobuf.Int32Value
+	46,  // 37: ad.UpdateCategoryResponse.category:type_name -> ad.Category
+	6,   // 38: ad.GetCategoryAttributesResponse.attributes:type_name -> ad.AttributeDef
+	6,   // 39: ad.SetCategoryAttributesRequest.attributes:type_name -> ad.AttributeDef
+	6,   // 40: ad.SetCategoryAttributesResponse.attributes:type_name -> ad.AttributeDef
+	3,   // 41: ad.RenewAdResponse.ad:type_name -> ad.Ad
+	3,   // 42: ad.RestoreAdResponse.ad:type_name -> ad.Ad
+	63,  // 43: ad.AdRevision.changes:type_name -> ad.FieldChange
+	64,  // 44: ad.ListAdRevisionsResponse.revisions:type_name -> ad.AdRevision
+	64,  // 45: ad.GetAdRevisionResponse.revision:type_name -> ad.AdRevision
+	69,  // 46: ad.GetPriceHistoryResponse.points:type_name -> ad.PricePoint
+	72,  // 47: ad.CreateSavedSearchResponse.saved_search:type_name -> ad.SavedSearch
+	72,  // 48: ad.UpdateSavedSearchResponse.saved_search:type_name -> ad.SavedSearch
+	72,  // 49: ad.ListSavedSearchesResponse.saved_searches:type_name -> ad.SavedSearch
+	3,   // 50: ad.ListSavedSearchMatchesResponse.ads:type_name -> ad.Ad
+	83,  // 51: ad.GetAdStatsResponse.days:type_name -> ad.AdDailyStats
+	0,   // 52: ad.AdStatsSummary.status:type_name -> ad.AdStatus
+	86,  // 53: ad.GetSellerStatsResponse.ads:type_name -> ad.AdStatsSummary
+	3,   // 54: ad.PromoteAdResponse.ad:type_name -> ad.Ad
+	3,   // 55: ad.BumpAdResponse.ad:type_name -> ad.Ad
+	95,  // 56: ad.GetBalanceResponse.entries:type_name -> ad.LedgerEntry
+	95,  // 57: ad.CreditBalanceResponse.entry:type_name -> ad.LedgerEntry
+	1,   // 58: ad.Report.status:type_name -> ad.ReportStatus
+	100, // 59: ad.ReportAdResponse.report:type_name -> ad.Report
+	1,   // 60: ad.ListReportsRequest.status:type_name -> ad.ReportStatus
+	100, // 61: ad.ListReportsResponse.reports:type_name -> ad.Report
+	100, // 62: ad.RejectReportResponse.report:type_name -> ad.Report
+	109, // 63: ad.ListModerationLogResponse.actions:type_name -> ad.ModerationAction
+	9,   // 64: ad.AdService.CreateAd:input_type -> ad.CreateAdRequest
+	11,  // 65: ad.AdService.GetAd:input_type -> ad.GetAdRequest
+	13,  // 66: ad.AdService.ListAds:input_type -> ad.ListAdsRequest
+	15,  // 67: ad.AdService.ListAdsByAuthor:input_type -> ad.ListAdsByAuthorRequest
+	16,  // 68: ad.AdService.UpdateAd:input_type -> ad.UpdateAdRequest
+	18,  // 69: ad.AdService.DeleteAd:input_type -> ad.DeleteAdRequest
+	20,  // 70: ad.AdService.AttachMedia:input_type -> ad.AttachMediaRequest
+	22,  // 71: ad.AdService.DetachMedia:input_type -> ad.DetachMediaRequest
+	24,  // 72: ad.AdService.ReplaceImages:input_type -> ad.ReplaceImagesRequest
+	26,  // 73: ad.AdService.ReorderImages:input_type -> ad.ReorderImagesRequest
+	28,  // 74: ad.AdService.SetPrimaryImage:input_type -> ad.SetPrimaryImageRequest
+	30,  // 75: ad.AdService.CreateAdWithImages:input_type -> ad.CreateAdWithImagesRequest
+	32,  // 76: ad.AdService.AddFavorite:input_type -> ad.AddFavoriteRequest
+	34,  // 77: ad.AdService.RemoveFavorite:input_type -> ad.RemoveFavoriteRequest
+	36,  // 78: ad.AdService.ListFavorites:input_type -> ad.ListFavoritesRequest
+	39,  // 79: ad.AdService.CreateReview:input_type -> ad.CreateReviewRequest
+	41,  // 80: ad.AdService.DeleteReview:input_type -> ad.DeleteReviewRequest
+	43,  // 81: ad.AdService.ListAdReviews:input_type -> ad.ListAdReviewsRequest
+	44,  // 82: ad.AdService.ListSellerReviews:input_type -> ad.ListSellerReviewsRequest
+	47,  // 83: ad.AdService.ListCategories:input_type -> ad.ListCategoriesRequest
+	49,  // 84: ad.AdService.CreateCategory:input_type -> ad.CreateCategoryRequest
+	51,  // 85: ad.AdService.UpdateCategory:input_type -> ad.UpdateCategoryRequest
+	53,  // 86: ad.AdService.ArchiveCategory:input_type -> ad.ArchiveCategoryRequest
+	55,  // 87: ad.AdService.GetCategoryAttributes:input_type -> ad.GetCategoryAttributesRequest
+	57,  // 88: ad.AdService.SetCategoryAttributes:input_type -> ad.SetCategoryAttributesRequest
+	59,  // 89: ad.AdService.RenewAd:input_type -> ad.RenewAdRequest
+	61,  // 90: ad.AdService.RestoreAd:input_type -> ad.RestoreAdRequest
+	65,  // 91: ad.AdService.ListAdRevisions:input_type -> ad.ListAdRevisionsRequest
+	67,  // 92: ad.AdService.GetAdRevision:input_type -> ad.GetAdRevisionRequest
+	70,  // 93: ad.AdService.GetPriceHistory:input_type -> ad.GetPriceHistoryRequest
+	84,  // 94: ad.AdService.GetAdStats:input_type -> ad.GetAdStatsRequest
+	87,  // 95: ad.AdService.GetSellerStats:input_type -> ad.GetSellerStatsRequest
+	89,  // 96: ad.AdService.RecordContactReveal:input_type -> ad.RecordContactRevealRequest
+	91,  // 97: ad.AdService.PromoteAd:input_type -> ad.PromoteAdRequest
+	93,  // 98: ad.AdService.BumpAd:input_type -> ad.BumpAdRequest
+	96,  // 99: ad.AdService.GetBalance:input_type -> ad.GetBalanceRequest
+	98,  // 100: ad.AdService.CreditBalance:input_type -> ad.CreditBalanceRequest
+	101, // 101: ad.AdService.ReportAd:input_type -> ad.ReportAdRequest
+	103, // 102: ad.AdService.ListReports:input_type -> ad.ListReportsRequest
+	105, // 103: ad.AdService.HideAd:input_type -> ad.ModerateAdRequest
+	105, // 104: ad.AdService.UnhideAd:input_type -> ad.ModerateAdRequest
+	107, // 105: ad.AdService.RejectReport:input_type -> ad.RejectReportRequest
+	110, // 106: ad.AdService.ListModerationLog:input_type -> ad.ListModerationLogRequest
+	73,  // 107: ad.AdService.CreateSavedSearch:input_type -> ad.CreateSavedSearchRequest
+	75,  // 108: ad.AdService.UpdateSavedSearch:input_type -> ad.UpdateSavedSearchRequest
+	77,  // 109: ad.AdService.DeleteSavedSearch:input_type -> ad.DeleteSavedSearchRequest
+	79,  // 110: ad.AdService.ListSavedSearches:input_type -> ad.ListSavedSearchesRequest
+	81,  // 111: ad.AdService.ListSavedSearchMatches:input_type -> ad.ListSavedSearchMatchesRequest
+	10,  // 112: ad.AdService.CreateAd:output_type -> ad.CreateAdResponse
+	12,  // 113: ad.AdService.GetAd:output_type -> ad.GetAdResponse
+	14,  // 114: ad.AdService.ListAds:output_type -> ad.ListAdsResponse
+	14,  // 115: ad.AdService.ListAdsByAuthor:output_type -> ad.ListAdsResponse
+	17,  // 116: ad.AdService.UpdateAd:output_type -> ad.UpdateAdResponse
+	19,  // 117: ad.AdService.DeleteAd:output_type -> ad.DeleteAdResponse
+	21,  // 118: ad.AdService.AttachMedia:output_type -> ad.AttachMediaResponse
+	23,  // 119: ad.AdService.DetachMedia:output_type -> ad.DetachMediaResponse
+	25,  // 120: ad.AdService.ReplaceImages:output_type -> ad.ReplaceImagesResponse
+	27,  // 121: ad.AdService.ReorderImages:output_type -> ad.ReorderImagesResponse
+	29,  // 122: ad.AdService.SetPrimaryImage:output_type -> ad.SetPrimaryImageResponse
+	31,  // 123: ad.AdService.CreateAdWithImages:output_type -> ad.CreateAdWithImagesResponse
+	33,  // 124: ad.AdService.AddFavorite:output_type -> ad.AddFavoriteResponse
+	35,  // 125: ad.AdService.RemoveFavorite:output_type -> ad.RemoveFavoriteResponse
+	37,  // 126: ad.AdService.ListFavorites:output_type -> ad.ListFavoritesResponse
+	40,  // 127: ad.AdService.CreateReview:output_type -> ad.CreateReviewResponse
+	42,  // 128: ad.AdService.DeleteReview:output_type -> ad.DeleteReviewResponse
+	45,  // 129: ad.AdService.ListAdReviews:output_type -> ad.ListReviewsResponse
+	45,  // 130: ad.AdService.ListSellerReviews:output_type -> ad.ListReviewsResponse
+	48,  // 131: ad.AdService.ListCategories:output_type -> ad.ListCategoriesResponse
+	50,  // 132: ad.AdService.CreateCategory:output_type -> ad.CreateCategoryResponse
+	52,  // 133: ad.AdService.UpdateCategory:output_type -> ad.UpdateCategoryResponse
+	54,  // 134: ad.AdService.ArchiveCategory:output_type -> ad.ArchiveCategoryResponse
+	56,  // 135: ad.AdService.GetCategoryAttributes:output_type -> ad.GetCategoryAttributesResponse
+	58,  // 136: ad.AdService.SetCategoryAttributes:output_type -> ad.SetCategoryAttributesResponse
+	60,  // 137: ad.AdService.RenewAd:output_type -> ad.RenewAdResponse
+	62,  // 138: ad.AdService.RestoreAd:output_type -> ad.RestoreAdResponse
+	66,  // 139: ad.AdService.ListAdRevisions:output_type -> ad.ListAdRevisionsResponse
+	68,  // 140: ad.AdService.GetAdRevision:output_type -> ad.GetAdRevisionResponse
+	71,  // 141: ad.AdService.GetPriceHistory:output_type -> ad.GetPriceHistoryResponse
+	85,  // 142: ad.AdService.GetAdStats:output_type -> ad.GetAdStatsResponse
+	88,  // 143: ad.AdService.GetSellerStats:output_type -> ad.GetSellerStatsResponse
+	90,  // 144: ad.AdService.RecordContactReveal:output_type -> ad.RecordContactRevealResponse
+	92,  // 145: ad.AdService.PromoteAd:output_type -> ad.PromoteAdResponse
+	94,  // 146: ad.AdService.BumpAd:output_type -> ad.BumpAdResponse
+	97,  // 147: ad.AdService.GetBalance:output_type -> ad.GetBalanceResponse
+	99,  // 148: ad.AdService.CreditBalance:output_type -> ad.CreditBalanceResponse
+	102, // 149: ad.AdService.ReportAd:output_type -> ad.ReportAdResponse
+	104, // 150: ad.AdService.ListReports:output_type -> ad.ListReportsResponse
+	106, // 151: ad.AdService.HideAd:output_type -> ad.ModerateAdResponse
+	106, // 152: ad.AdService.UnhideAd:output_type -> ad.ModerateAdResponse
+	108, // 153: ad.AdService.RejectReport:output_type -> ad.RejectReportResponse
+	111, // 154: ad.AdService.ListModerationLog:output_type -> ad.ListModerationLogResponse
+	74,  // 155: ad.AdService.CreateSavedSearch:output_type -> ad.CreateSavedSearchResponse
+	76,  // 156: ad.AdService.UpdateSavedSearch:output_type -> ad.UpdateSavedSearchResponse
+	78,  // 157: ad.AdService.DeleteSavedSearch:output_type -> ad.DeleteSavedSearchResponse
+	80,  // 158: ad.AdService.ListSavedSearches:output_type -> ad.ListSavedSearchesResponse
+	82,  // 159: ad.AdService.ListSavedSearchMatches:output_type -> ad.ListSavedSearchMatchesResponse
+	112, // [112:160] is the sub-list for method output_type
+	64,  // [64:112] is the sub-list for method input_type
+	64,  // [64:64] is the sub-list for extension type_name
+	64,  // [64:64] is the sub-list for extension extendee
+	0,   // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_ad_proto_init() }
@@ -1293,18 +8019,26 @@ func file_ad_proto_init() {
 	if File_ad_proto != nil {
 		return
 	}
+	file_ad_proto_msgTypes[1].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[11].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[70].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ad_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ad_proto_rawDesc), len(file_ad_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ad_proto_goTypes,
 		DependencyIndexes: file_ad_proto_depIdxs,
+		EnumInfos:         file_ad_proto_enumTypes,
 		MessageInfos:      file_ad_proto_msgTypes,
 	}.Build()
 	File_ad_proto = out.File
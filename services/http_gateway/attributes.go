@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const attrParamPrefix = "attr."
+
+// attributeFilters собирает фильтры по характеристикам из query:
+// attr.<key>=значение — равенство, attr.<key>.min / attr.<key>.max — диапазон для чисел.
+// ok=false — граница диапазона не число.
+func attributeFilters(q url.Values) ([]*adpb.AttributeFilter, bool) {
+	byKey := map[string]*adpb.AttributeFilter{}
+	for name := range q {
+		rest, found := strings.CutPrefix(name, attrParamPrefix)
+		if !found || rest == "" {
+			continue
+		}
+		key, bound, _ := strings.Cut(rest, ".")
+		f := byKey[key]
+		if f == nil {
+			f = &adpb.AttributeFilter{Key: key}
+			byKey[key] = f
+		}
+		v := q.Get(name)
+		switch bound {
+		case "":
+			f.Equals = &v
+		case "min", "max":
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, false
+			}
+			if bound == "min" {
+				f.Min = &n
+			} else {
+				f.Max = &n
+			}
+		default:
+			return nil, false
+		}
+	}
+	res := make([]*adpb.AttributeFilter, 0, len(byKey))
+	for _, f := range byKey {
+		res = append(res, f)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res, true
+}
+
+// handleCategoryByID обслуживает /api/categories/{id}/attributes:
+// GET — схема характеристик категории с учётом родителей, PUT — замена собственных характеристик (администраторы).
+func (g *gateway) handleCategoryByID(w http.ResponseWriter, r *http.Request) {
+	id, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/categories/"), "/")
+	if id == "" || sub != "attributes" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		g.getCategoryAttributes(w, r, id)
+	case http.MethodPut:
+		g.setCategoryAttributes(w, r, id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *gateway) getCategoryAttributes(w http.ResponseWriter, r *http.Request, categoryID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.GetCategoryAttributes(ctx, &adpb.GetCategoryAttributesRequest{CategoryId: categoryID})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		// неизвестная категория
+		w.WriteHeader(http.StatusNotFound)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+type setCategoryAttributesRequest struct {
+	Attributes []struct {
+		Key      string   `json:"key"`
+		Name     string   `json:"name"`
+		Type     string   `json:"type"` // enum, number, bool, text
+		Required bool     `json:"required"`
+		Options  []string `json:"options"`
+	} `json:"attributes"`
+}
+
+func (g *gateway) setCategoryAttributes(w http.ResponseWriter, r *http.Request, categoryID string) {
+	var body setCategoryAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	req := &adpb.SetCategoryAttributesRequest{UserId: userID, CategoryId: categoryID}
+	for _, a := range body.Attributes {
+		req.Attributes = append(req.Attributes, &adpb.AttributeDef{Key: a.Key, Name: a.Name, Type: a.Type, Required: a.Required, Options: a.Options})
+	}
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.SetCategoryAttributes(ctx, req)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
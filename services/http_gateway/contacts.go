@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+	userpb "78-pflops/services/user_service/gen/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRevealLimit  = 30
+	defaultRevealWindow = time.Hour
+)
+
+// rateLimiter — скользящее окно запросов на ключ (user_id) в памяти шлюза.
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	hits      map[string][]time.Time
+	nextSweep time.Time
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{limit: limit, window: window, hits: map[string][]time.Time{}}
+}
+
+// allow засчитывает запрос, если лимит не исчерпан; иначе возвращает, через сколько можно повторить.
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cutoff := now.Add(-l.window)
+	if now.After(l.nextSweep) {
+		// ключи без свежих запросов больше не нужны
+		for k, ts := range l.hits {
+			if !ts[len(ts)-1].After(cutoff) {
+				delete(l.hits, k)
+			}
+		}
+		l.nextSweep = now.Add(l.window)
+	}
+	ts := l.hits[key]
+	i := 0
+	for i < len(ts) && !ts[i].After(cutoff) {
+		i++
+	}
+	ts = ts[i:]
+	if len(ts) >= l.limit {
+		l.hits[key] = ts
+		return false, ts[0].Sub(cutoff)
+	}
+	l.hits[key] = append(ts, now)
+	return true, 0
+}
+
+// revealLimiterFromEnv читает CONTACT_REVEAL_LIMIT (показов на пользователя) и CONTACT_REVEAL_WINDOW (окно).
+func revealLimiterFromEnv() *rateLimiter {
+	limit, window := defaultRevealLimit, defaultRevealWindow
+	if v := getenv("CONTACT_REVEAL_LIMIT", ""); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			limit = n
+		} else {
+			log.Printf("invalid CONTACT_REVEAL_LIMIT %q, using %d", v, limit)
+		}
+	}
+	if v := getenv("CONTACT_REVEAL_WINDOW", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			window = d
+		} else {
+			log.Printf("invalid CONTACT_REVEAL_WINDOW %q, using %s", v, window)
+		}
+	}
+	return newRateLimiter(limit, window)
+}
+
+// revealContacts открывает контакты продавца объявления (POST /api/ads/{id}/contacts).
+// Только для авторизованных пользователей, с лимитом показов; каждый показ учитывается в статистике объявления.
+func (g *gateway) revealContacts(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+	if ok, retry := g.reveals.allow(userID, time.Now()); !ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retry.Seconds())+1))
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	adConn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer adConn.Close()
+
+	reveal, err := adpb.NewAdServiceClient(adConn).RecordContactReveal(ctx, &adpb.RecordContactRevealRequest{AdId: adID, UserId: userID})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.FailedPrecondition:
+		// объявление продано — контакты больше не показываются
+		w.WriteHeader(http.StatusConflict)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	userConn, err := grpc.DialContext(ctx, g.userSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer userConn.Close()
+
+	contacts, err := userpb.NewUserServiceClient(userConn).GetContacts(ctx, &userpb.GetContactsRequest{UserId: reveal.SellerId})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"user_id":           contacts.UserId,
+		"name":              contacts.Name,
+		"phone":             contacts.Phone,
+		"email":             contacts.Email,
+		"preferred_channel": contacts.PreferredChannel,
+	})
+}
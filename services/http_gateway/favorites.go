@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+)
+
+type addFavoriteRequest struct {
+	AdID string `json:"ad_id"`
+}
+
+// handleFavorites обрабатывает /api/favorites: GET — список избранного, POST — добавить объявление.
+func (g *gateway) handleFavorites(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		g.listFavorites(w, r)
+	case http.MethodPost:
+		var req addFavoriteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.AdID == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g.addFavorite(w, r, req.AdID)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleFavoriteByID обрабатывает /api/favorites/{ad_id}: PUT — добавить, DELETE — убрать из избранного.
+func (g *gateway) handleFavoriteByID(w http.ResponseWriter, r *http.Request) {
+	adID := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/favorites"), "/")
+	if adID == "" || strings.Contains(adID, "/") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		g.addFavorite(w, r, adID)
+	case http.MethodDelete:
+		g.removeFavorite(w, r, adID)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *gateway) listFavorites(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var page, pageSize int64
+	if v := q.Get("page"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		page = p
+	}
+	if v := q.Get("page_size"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		pageSize = p
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.ListFavorites(ctx, &adpb.ListFavoritesRequest{
+		UserId:   userID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		w.WriteHeader(favoriteStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *gateway) addFavorite(w http.ResponseWriter, r *http.Request, adID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	_, err = client.AddFavorite(ctx, &adpb.AddFavoriteRequest{UserId: userID, AdId: adID})
+	if err != nil {
+		w.WriteHeader(favoriteStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (g *gateway) removeFavorite(w http.ResponseWriter, r *http.Request, adID string) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	_, err = client.RemoveFavorite(ctx, &adpb.RemoveFavoriteRequest{UserId: userID, AdId: adID})
+	if err != nil {
+		w.WriteHeader(favoriteStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// favoriteStatus переводит ошибку ad_service в HTTP-статус.
+func favoriteStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusBadGateway
+	}
+}
@@ -13,11 +13,13 @@ import (
 	"time"
 
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
 	mediapb "78-pflops/services/http_gateway/mediapb"
-	userpb "78-pflops/services/user_service/pb/user_service/pb"
+	userpb "78-pflops/services/user_service/gen/proto"
 )
 
 type gateway struct {
@@ -25,6 +27,7 @@ type gateway struct {
 	adSvcAddr    string
 	userHTTPBase string
 	mediaSvcAddr string
+	reveals      *rateLimiter // лимит показов контактов продавцов на пользователя
 }
 
 type registerRequest struct {
@@ -39,20 +42,50 @@ type loginRequest struct {
 }
 
 type createAdRequest struct {
-	Token       string   `json:"token"`
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Price       float64  `json:"price"`
-	Category    string   `json:"category"`
-	Images      []string `json:"images"`
+	Token       string            `json:"token"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Price       float64           `json:"price"`
+	Category    string            `json:"category"`
+	Images      []string          `json:"images"`
+	Location    *locationBody     `json:"location"`
+	Attributes  map[string]string `json:"attributes"` // характеристики по схеме категории
 }
 
 type updateAdRequest struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Price       float64  `json:"price"`
-	Category    string   `json:"category"`
-	Images      []string `json:"images"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Price       float64       `json:"price"`
+	Category    string        `json:"category"`
+	Images      []string      `json:"images"`
+	Status      string        `json:"status"`   // ACTIVE, INACTIVE, SOLD, ARCHIVED
+	Location    *locationBody `json:"location"` // заменяет местоположение целиком; {} — сбросить
+	// Заменяет характеристики целиком; {} — сбросить, отсутствие поля — не менять.
+	Attributes map[string]string `json:"attributes"`
+}
+
+// locationBody — местоположение объявления: город и/или регион, координаты необязательны.
+type locationBody struct {
+	City   string   `json:"city"`
+	Region string   `json:"region"`
+	Lat    *float64 `json:"lat"`
+	Lon    *float64 `json:"lon"`
+}
+
+func (l *locationBody) toPb() *adpb.Location {
+	if l == nil {
+		return nil
+	}
+	return &adpb.Location{City: l.City, Region: l.Region, Lat: l.Lat, Lon: l.Lon}
+}
+
+// parseAdStatus переводит ACTIVE/SOLD/... (без учёта регистра) в enum; пустая строка — UNSPECIFIED.
+func parseAdStatus(s string) (adpb.AdStatus, bool) {
+	if s == "" {
+		return adpb.AdStatus_AD_STATUS_UNSPECIFIED, true
+	}
+	v, ok := adpb.AdStatus_value["AD_STATUS_"+strings.ToUpper(s)]
+	return adpb.AdStatus(v), ok && v != 0
 }
 
 func getenv(key, def string) string {
@@ -69,12 +102,23 @@ func main() {
 	userHTTPBase := getenv("USER_HTTP_BASE", "http://user_service_app:8081")
 	mediaSvcAddr := getenv("MEDIA_SERVICE_ADDR", "media_service_app:50053")
 
-	g := &gateway{userSvcAddr: userSvcAddr, adSvcAddr: adSvcAddr, userHTTPBase: userHTTPBase, mediaSvcAddr: mediaSvcAddr}
+	g := &gateway{userSvcAddr: userSvcAddr, adSvcAddr: adSvcAddr, userHTTPBase: userHTTPBase, mediaSvcAddr: mediaSvcAddr, reveals: revealLimiterFromEnv()}
 
 	http.HandleFunc("/api/auth/register", g.handleRegister)
 	http.HandleFunc("/api/auth/login", g.handleLogin)
 	http.HandleFunc("/api/ads", g.handleAds)
 	http.HandleFunc("/api/ads/", g.handleAdByID)
+	http.HandleFunc("/api/categories", g.listCategories)
+	http.HandleFunc("/api/categories/", g.handleCategoryByID)
+	http.HandleFunc("/api/favorites", g.handleFavorites)
+	http.HandleFunc("/api/favorites/", g.handleFavoriteByID)
+	http.HandleFunc("/api/users/", g.handleUserAds)
+	http.HandleFunc("/api/saved-searches", g.handleSavedSearches)
+	http.HandleFunc("/api/saved-searches/", g.handleSavedSearchByID)
+	http.HandleFunc("/api/dashboard", g.handleDashboard)
+	http.HandleFunc("/api/balance", g.handleBalance)
+	http.HandleFunc("/api/balance/credit", g.creditBalance)
+	http.HandleFunc("/api/moderation/", g.handleModeration)
 
 	log.Printf("HTTP gateway listening on %s", port)
 	if err := http.ListenAndServe(":"+port, nil); err != nil {
@@ -82,6 +126,56 @@ func main() {
 	}
 }
 
+// authenticate проверяет заголовок Authorization: Bearer <token> через /api/users/me
+// и возвращает user_id. При ошибке вторым значением возвращается HTTP-статус для ответа.
+func (g *gateway) authenticate(ctx context.Context, r *http.Request) (string, int) {
+	authHeader := r.Header.Get("Authorization")
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(authHeader, bearerPrefix) {
+		return "", http.StatusUnauthorized
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authHeader, bearerPrefix))
+	if token == "" {
+		return "", http.StatusUnauthorized
+	}
+
+	meReq, err := http.NewRequestWithContext(ctx, http.MethodGet, g.userHTTPBase+"/api/users/me", nil)
+	if err != nil {
+		return "", http.StatusInternalServerError
+	}
+	meReq.Header.Set("Authorization", "Bearer "+token)
+
+	meResp, err := http.DefaultClient.Do(meReq)
+	if err != nil {
+		return "", http.StatusBadGateway
+	}
+	defer meResp.Body.Close()
+
+	if meResp.StatusCode != http.StatusOK {
+		return "", http.StatusUnauthorized
+	}
+	var me struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(meResp.Body).Decode(&me); err != nil || me.UserID == "" {
+		return "", http.StatusUnauthorized
+	}
+	return me.UserID, http.StatusOK
+}
+
+// viewerID возвращает user_id, если запрос пришёл с валидным токеном, иначе пустую строку.
+// Используется для публичных эндпоинтов, где авторизация необязательна.
+func (g *gateway) viewerID(ctx context.Context, r *http.Request) string {
+	if r.Header.Get("Authorization") == "" {
+		return ""
+	}
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		return ""
+	}
+	return userID
+}
+
 func (g *gateway) handleRegister(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		w.WriteHeader(http.StatusMethodNotAllowed)
@@ -164,7 +258,9 @@ func (g *gateway) handleAds(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// handleAdByID обрабатывает запросы /api/ads/{id} для получения, обновления и удаления объявления.
+// handleAdByID обрабатывает запросы /api/ads/{id} для получения, обновления и удаления объявления,
+// а также POST /api/ads/{id}/renew, POST /api/ads/{id}/restore, GET /api/ads/{id}/price-history
+// и GET /api/ads/{id}/stats.
 func (g *gateway) handleAdByID(w http.ResponseWriter, r *http.Request) {
 	// Ожидаем путь формата /api/ads/{id}
 	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/ads"), "/")
@@ -173,6 +269,42 @@ func (g *gateway) handleAdByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	id := parts[1]
+	if len(parts) > 2 {
+		if parts[2] == "renew" && len(parts) == 3 {
+			g.renewAd(w, r, id)
+			return
+		}
+		if parts[2] == "restore" && len(parts) == 3 {
+			g.restoreAd(w, r, id)
+			return
+		}
+		if parts[2] == "price-history" && len(parts) == 3 {
+			g.priceHistory(w, r, id)
+			return
+		}
+		if parts[2] == "stats" && len(parts) == 3 {
+			g.adStats(w, r, id)
+			return
+		}
+		if parts[2] == "contacts" && len(parts) == 3 {
+			g.revealContacts(w, r, id)
+			return
+		}
+		if parts[2] == "promote" && len(parts) == 3 {
+			g.promoteAd(w, r, id)
+			return
+		}
+		if parts[2] == "bump" && len(parts) == 3 {
+			g.bumpAd(w, r, id)
+			return
+		}
+		if parts[2] == "report" && len(parts) == 3 {
+			g.reportAd(w, r, id)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
 
 	switch r.Method {
 	case http.MethodGet:
@@ -189,14 +321,15 @@ func (g *gateway) handleAdByID(w http.ResponseWriter, r *http.Request) {
 func (g *gateway) listAds(w http.ResponseWriter, r *http.Request) {
 	q := r.URL.Query()
 
-	var minPrice, maxPrice int64
+	// nil — параметр не передан; min_price=0 — это тоже граница
+	var minPrice, maxPrice *int64
 	if v := q.Get("min_price"); v != "" {
 		p, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
-		minPrice = p
+		minPrice = &p
 	}
 	if v := q.Get("max_price"); v != "" {
 		p, err := strconv.ParseInt(v, 10, 64)
@@ -204,7 +337,59 @@ func (g *gateway) listAds(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
-		maxPrice = p
+		maxPrice = &p
+	}
+	var minRating *float64
+	if v := q.Get("min_rating"); v != "" {
+		p, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		minRating = &p
+	}
+	var page, pageSize int64
+	if v := q.Get("page"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		page = p
+	}
+	if v := q.Get("page_size"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		pageSize = p
+	}
+	// статус, отличный от ACTIVE, показывает только собственные объявления (нужен токен)
+	adStatus, ok := parseAdStatus(q.Get("status"))
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// поиск в радиусе: lat, lon и radius_km передаются вместе
+	var near *adpb.GeoFilter
+	if q.Has("lat") || q.Has("lon") || q.Has("radius_km") {
+		var coords [3]float64
+		for i, name := range []string{"lat", "lon", "radius_km"} {
+			v, err := strconv.ParseFloat(q.Get(name), 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			coords[i] = v
+		}
+		near = &adpb.GeoFilter{Lat: coords[0], Lon: coords[1], RadiusKm: coords[2]}
+	}
+	// фильтры по характеристикам работают только вместе с category
+	attrs, ok := attributeFilters(q)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
@@ -219,11 +404,32 @@ func (g *gateway) listAds(w http.ResponseWriter, r *http.Request) {
 
 	client := adpb.NewAdServiceClient(conn)
 	resp, err := client.ListAds(ctx, &adpb.ListAdsRequest{
-		Text:       q.Get("query"),
-		CategoryId: q.Get("category"),
-		PriceMin:   minPrice,
-		PriceMax:   maxPrice,
+		Text:                 q.Get("query"),
+		CategoryId:           q.Get("category"),
+		IncludeSubcategories: q.Get("subcategories") == "true",
+		PriceMin:             minPrice,
+		PriceMax:             maxPrice,
+		Condition:            strings.ToUpper(q.Get("condition")),
+		MinSellerRating:      minRating,
+		ViewerId:             g.viewerID(ctx, r),
+		Page:                 int32(page),
+		PageSize:             int32(pageSize),
+		PageToken:            q.Get("page_token"),
+		Sort:                 q.Get("sort"),
+		Status:               adStatus,
+		City:                 q.Get("city"),
+		Region:               q.Get("region"),
+		Near:                 near,
+		Attributes:           attrs,
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if status.Code(err) == codes.PermissionDenied {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusBadGateway)
 		return
@@ -329,7 +535,14 @@ func (g *gateway) createAd(w http.ResponseWriter, r *http.Request) {
 		Description: req.Description,
 		Price:       int64(req.Price),
 		MediaIds:    mediaIDs,
+		CategoryId:  req.Category,
+		Location:    req.Location.toPb(),
+		Attributes:  req.Attributes,
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusBadGateway)
 		return
@@ -339,6 +552,34 @@ func (g *gateway) createAd(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(createResp)
 }
 
+// listCategories возвращает дерево активных категорий.
+func (g *gateway) listCategories(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.ListCategories(ctx, &adpb.ListCategoriesRequest{})
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 // getAd возвращает одно объявление по ID.
 func (g *gateway) getAd(w http.ResponseWriter, r *http.Request, id string) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
@@ -352,7 +593,11 @@ func (g *gateway) getAd(w http.ResponseWriter, r *http.Request, id string) {
 	defer conn.Close()
 
 	client := adpb.NewAdServiceClient(conn)
-	resp, err := client.GetAd(ctx, &adpb.GetAdRequest{Id: id})
+	resp, err := client.GetAd(ctx, &adpb.GetAdRequest{Id: id, ViewerId: g.viewerID(ctx, r), ClientId: clientIP(r)})
+	if status.Code(err) == codes.NotFound {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusBadGateway)
 		return
@@ -362,7 +607,7 @@ func (g *gateway) getAd(w http.ResponseWriter, r *http.Request, id string) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
-// updateAd обновляет объявление (заголовок, описание, цену, категорию).
+// updateAd обновляет объявление (заголовок, описание, цену, категорию, статус).
 // Требуется заголовок Authorization: Bearer <token>.
 func (g *gateway) updateAd(w http.ResponseWriter, r *http.Request, id string) {
 	// Получаем токен из заголовка
@@ -452,7 +697,13 @@ func (g *gateway) updateAd(w http.ResponseWriter, r *http.Request, id string) {
 		}
 	}
 
-	if reqBody.Title == "" && reqBody.Description == "" && reqBody.Price == 0 && reqBody.Category == "" && len(newMediaIDs) == 0 {
+	adStatus, ok := parseAdStatus(reqBody.Status)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if reqBody.Title == "" && reqBody.Description == "" && reqBody.Price == 0 && reqBody.Category == "" && adStatus == adpb.AdStatus_AD_STATUS_UNSPECIFIED && reqBody.Location == nil && reqBody.Attributes == nil && len(newMediaIDs) == 0 {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
@@ -482,11 +733,28 @@ func (g *gateway) updateAd(w http.ResponseWriter, r *http.Request, id string) {
 	if reqBody.Category != "" {
 		updateReq.CategoryId = wrapperspb.String(reqBody.Category)
 	}
+	updateReq.Status = adStatus
+	updateReq.Location = reqBody.Location.toPb()
+	if reqBody.Attributes != nil {
+		updateReq.Attributes = &adpb.AttributeValues{Values: reqBody.Attributes}
+	}
 
-	// Если есть изменения текста/цены/категории — отправляем UpdateAd
-	if updateReq.Title != nil || updateReq.Description != nil || updateReq.Price != nil || updateReq.CategoryId != nil {
+	// Если есть изменения текста/цены/категории/статуса/местоположения/характеристик — отправляем UpdateAd
+	if updateReq.Title != nil || updateReq.Description != nil || updateReq.Price != nil || updateReq.CategoryId != nil || updateReq.Status != adpb.AdStatus_AD_STATUS_UNSPECIFIED || updateReq.Location != nil || updateReq.Attributes != nil {
 		if _, err := adClient.UpdateAd(ctx, updateReq); err != nil {
-			w.WriteHeader(http.StatusBadGateway)
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				w.WriteHeader(http.StatusBadRequest)
+			case codes.PermissionDenied:
+				w.WriteHeader(http.StatusForbidden)
+			case codes.NotFound:
+				w.WriteHeader(http.StatusNotFound)
+			case codes.FailedPrecondition, codes.Aborted:
+				// недопустимый переход статуса (например, SOLD -> ACTIVE)
+				w.WriteHeader(http.StatusConflict)
+			default:
+				w.WriteHeader(http.StatusBadGateway)
+			}
 			return
 		}
 	}
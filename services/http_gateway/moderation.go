@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseReportStatus переводит open/in_review/resolved (без учёта регистра) в enum; пустая строка — UNSPECIFIED.
+func parseReportStatus(s string) (adpb.ReportStatus, bool) {
+	if s == "" {
+		return adpb.ReportStatus_REPORT_STATUS_UNSPECIFIED, true
+	}
+	v, ok := adpb.ReportStatus_value["REPORT_STATUS_"+strings.ToUpper(s)]
+	return adpb.ReportStatus(v), ok && v != 0
+}
+
+// moderationComment читает необязательное тело {"comment": "..."}.
+func moderationComment(r *http.Request) (string, bool) {
+	var body struct {
+		Comment string `json:"comment"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body.Comment, err == nil || errors.Is(err, io.EOF)
+}
+
+// reportAd принимает жалобу на объявление (POST /api/ads/{id}/report, тело {"reason", "comment"}).
+func (g *gateway) reportAd(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		Reason  string `json:"reason"`
+		Comment string `json:"comment"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Reason == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).ReportAd(ctx, &adpb.ReportAdRequest{AdId: adID, UserId: userID, Reason: body.Reason, Comment: body.Comment})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.AlreadyExists:
+		// жалоба этого пользователя ещё не рассмотрена
+		w.WriteHeader(http.StatusConflict)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(resp.Report)
+}
+
+// handleModeration — административные маршруты, доступ проверяет ad_service по AD_ADMIN_IDS:
+//
+//	GET  /api/moderation/reports?status=&ad_id=&page=&page_size=
+//	POST /api/moderation/reports/{id}/reject
+//	POST /api/moderation/ads/{id}/hide
+//	POST /api/moderation/ads/{id}/unhide
+//	GET  /api/moderation/ads/{id}/log?page=&page_size=
+func (g *gateway) handleModeration(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/moderation/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "reports":
+		g.listReports(w, r)
+	case len(parts) == 3 && parts[0] == "reports" && parts[1] != "" && parts[2] == "reject":
+		g.rejectReport(w, r, parts[1])
+	case len(parts) == 3 && parts[0] == "ads" && parts[1] != "" && (parts[2] == "hide" || parts[2] == "unhide"):
+		g.moderateAd(w, r, parts[1], parts[2] == "hide")
+	case len(parts) == 3 && parts[0] == "ads" && parts[1] != "" && parts[2] == "log":
+		g.moderationLog(w, r, parts[1])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (g *gateway) listReports(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	reportStatus, ok1 := parseReportStatus(q.Get("status"))
+	page, ok2 := intParam(r, "page")
+	pageSize, ok3 := intParam(r, "page_size")
+	if !ok1 || !ok2 || !ok3 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).ListReports(ctx, &adpb.ListReportsRequest{
+		UserId: userID, Status: reportStatus, AdId: q.Get("ad_id"), Page: page, PageSize: pageSize,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *gateway) rejectReport(w http.ResponseWriter, r *http.Request, reportID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	comment, ok := moderationComment(r)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).RejectReport(ctx, &adpb.RejectReportRequest{UserId: userID, ReportId: reportID, Comment: comment})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case codes.FailedPrecondition:
+		// жалоба уже рассмотрена
+		w.WriteHeader(http.StatusConflict)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Report)
+}
+
+// moderateAd скрывает объявление (hide) или возвращает его в выдачу (unhide).
+func (g *gateway) moderateAd(w http.ResponseWriter, r *http.Request, adID string, hide bool) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	comment, ok := moderationComment(r)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	req := &adpb.ModerateAdRequest{UserId: userID, AdId: adID, Comment: comment}
+	if hide {
+		_, err = client.HideAd(ctx, req)
+	} else {
+		_, err = client.UnhideAd(ctx, req)
+	}
+	switch status.Code(err) {
+	case codes.OK:
+		w.WriteHeader(http.StatusNoContent)
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+	case codes.FailedPrecondition:
+		// unhide для объявления, которое не скрыто
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+	}
+}
+
+func (g *gateway) moderationLog(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	page, ok1 := intParam(r, "page")
+	pageSize, ok2 := intParam(r, "page_size")
+	if !ok1 || !ok2 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).ListModerationLog(ctx, &adpb.ListModerationLogRequest{UserId: userID, AdId: adID, Page: page, PageSize: pageSize})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// priceHistory отдаёт историю цен объявления (GET /api/ads/{id}/price-history) для графика:
+// точки {price, changed_at} от старой к новой.
+func (g *gateway) priceHistory(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.GetPriceHistory(ctx, &adpb.GetPriceHistoryRequest{AdId: adID, ViewerId: g.viewerID(ctx, r)})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
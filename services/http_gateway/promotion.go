@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// promoteAd продвигает объявление за кредиты с баланса владельца (POST /api/ads/{id}/promote, тело {"days": N}).
+func (g *gateway) promoteAd(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		Days int32 `json:"days"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).PromoteAd(ctx, &adpb.PromoteAdRequest{AdId: adID, UserId: userID, Days: body.Days})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case codes.FailedPrecondition:
+		// не хватает кредитов или объявление не опубликовано
+		w.WriteHeader(http.StatusConflict)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// bumpAd бесплатно поднимает объявление в выдаче (POST /api/ads/{id}/bump).
+func (g *gateway) bumpAd(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).BumpAd(ctx, &adpb.BumpAdRequest{AdId: adID, UserId: userID})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case codes.FailedPrecondition:
+		w.WriteHeader(http.StatusConflict)
+		return
+	case codes.ResourceExhausted:
+		// пауза между поднятиями ещё не прошла
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handleBalance: GET /api/balance?page=&page_size= — свой баланс и операции.
+func (g *gateway) handleBalance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	page, ok1 := intParam(r, "page")
+	pageSize, ok2 := intParam(r, "page_size")
+	if !ok1 || !ok2 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).GetBalance(ctx, &adpb.GetBalanceRequest{UserId: userID, Page: page, PageSize: pageSize})
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// creditBalance: POST /api/balance/credit {"user_id", "amount", "comment"} — начисление кредитов администратором.
+func (g *gateway) creditBalance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		UserID  string `json:"user_id"`
+		Amount  int64  `json:"amount"`
+		Comment string `json:"comment"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.UserID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	adminID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := adpb.NewAdServiceClient(conn).CreditBalance(ctx, &adpb.CreditBalanceRequest{
+		UserId: adminID, TargetUserId: body.UserID, Amount: body.Amount, Comment: body.Comment,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
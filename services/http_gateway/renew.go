@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// renewAd продлевает объявление (POST /api/ads/{id}/renew); архивное объявление снова публикуется.
+func (g *gateway) renewAd(w http.ResponseWriter, r *http.Request, adID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	resp, err := client.RenewAd(ctx, &adpb.RenewAdRequest{AdId: adID, UserId: userID})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case codes.FailedPrecondition, codes.Aborted:
+		// проданное объявление продлить нельзя
+		w.WriteHeader(http.StatusConflict)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
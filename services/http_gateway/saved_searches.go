@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// savedSearchBody — тело POST /api/saved-searches и PUT /api/saved-searches/{id}.
+type savedSearchBody struct {
+	Name                 string `json:"name"`
+	Text                 string `json:"text"`
+	CategoryID           string `json:"category_id"`
+	IncludeSubcategories bool   `json:"include_subcategories"`
+	PriceMin             *int64 `json:"price_min"`
+	PriceMax             *int64 `json:"price_max"`
+	Condition            string `json:"condition"`
+}
+
+// savedSearchStatus переводит ошибку ad_service в HTTP-статус.
+func savedSearchStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusConflict
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// handleSavedSearches обрабатывает /api/saved-searches: GET — список с числом новых совпадений, POST — сохранить поиск.
+func (g *gateway) handleSavedSearches(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body savedSearchBody
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	var resp any
+	if r.Method == http.MethodGet {
+		resp, err = client.ListSavedSearches(ctx, &adpb.ListSavedSearchesRequest{UserId: userID})
+	} else {
+		resp, err = client.CreateSavedSearch(ctx, &adpb.CreateSavedSearchRequest{
+			UserId:               userID,
+			Name:                 body.Name,
+			Text:                 body.Text,
+			CategoryId:           body.CategoryID,
+			IncludeSubcategories: body.IncludeSubcategories,
+			PriceMin:             body.PriceMin,
+			PriceMax:             body.PriceMax,
+			Condition:            body.Condition,
+		})
+	}
+	if err != nil {
+		w.WriteHeader(savedSearchStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handleSavedSearchByID обрабатывает /api/saved-searches/{id}: PUT — заменить имя и фильтры, DELETE — удалить,
+// а также GET /api/saved-searches/{id}/matches — объявления, найденные с последнего просмотра.
+// Просмотр последней страницы отмечает совпадения просмотренными, если не передан ?mark_seen=false.
+func (g *gateway) handleSavedSearchByID(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/saved-searches"), "/"), "/")
+	id := parts[0]
+	switch {
+	case id == "" || len(parts) > 2 || len(parts) == 2 && parts[1] != "matches":
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case len(parts) == 2 && r.Method != http.MethodGet,
+		len(parts) == 1 && r.Method != http.MethodPut && r.Method != http.MethodDelete:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body savedSearchBody
+	var page, pageSize int64
+	markSeen := true
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPut:
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	case r.Method == http.MethodGet:
+		var err error
+		if v := q.Get("page"); v != "" {
+			if page, err = strconv.ParseInt(v, 10, 32); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		if v := q.Get("page_size"); v != "" {
+			if pageSize, err = strconv.ParseInt(v, 10, 32); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		if v := q.Get("mark_seen"); v != "" {
+			if markSeen, err = strconv.ParseBool(v); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, code := g.authenticate(ctx, r)
+	if code != http.StatusOK {
+		w.WriteHeader(code)
+		return
+	}
+
+	conn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	client := adpb.NewAdServiceClient(conn)
+	var resp any
+	switch r.Method {
+	case http.MethodGet:
+		resp, err = client.ListSavedSearchMatches(ctx, &adpb.ListSavedSearchMatchesRequest{
+			Id:       id,
+			UserId:   userID,
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+			MarkSeen: markSeen,
+		})
+	case http.MethodPut:
+		resp, err = client.UpdateSavedSearch(ctx, &adpb.UpdateSavedSearchRequest{
+			Id:                   id,
+			UserId:               userID,
+			Name:                 body.Name,
+			Text:                 body.Text,
+			CategoryId:           body.CategoryID,
+			IncludeSubcategories: body.IncludeSubcategories,
+			PriceMin:             body.PriceMin,
+			PriceMax:             body.PriceMax,
+			Condition:            body.Condition,
+		})
+	case http.MethodDelete:
+		_, err = client.DeleteSavedSearch(ctx, &adpb.DeleteSavedSearchRequest{Id: id, UserId: userID})
+	}
+	if err != nil {
+		w.WriteHeader(savedSearchStatus(err))
+		return
+	}
+	if r.Method == http.MethodDelete {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	adpb "78-pflops/services/ad_service/pb/ad_service/pb"
+	userpb "78-pflops/services/user_service/gen/proto"
+)
+
+// handleUserAds обрабатывает GET /api/users/{id}/ads — страницу продавца: публичный профиль
+// из user_service и его объявления. Владелец (по токену) видит объявления во всех статусах.
+func (g *gateway) handleUserAds(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/users/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "ads" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	authorID := parts[0]
+
+	q := r.URL.Query()
+	var page, pageSize int64
+	if v := q.Get("page"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		page = p
+	}
+	if v := q.Get("page_size"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		pageSize = p
+	}
+	adStatus, ok := parseAdStatus(q.Get("status"))
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	userConn, err := grpc.DialContext(ctx, g.userSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer userConn.Close()
+
+	profile, err := userpb.NewUserServiceClient(userConn).GetProfile(ctx, &userpb.GetProfileRequest{UserId: authorID})
+	if status.Code(err) == codes.NotFound {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	adConn, err := grpc.DialContext(ctx, g.adSvcAddr, grpc.WithInsecure())
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer adConn.Close()
+
+	resp, err := adpb.NewAdServiceClient(adConn).ListAdsByAuthor(ctx, &adpb.ListAdsByAuthorRequest{
+		AuthorId:  authorID,
+		ViewerId:  g.viewerID(ctx, r),
+		Status:    adStatus,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		PageToken: q.Get("page_token"),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+		return
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"profile":         map[string]string{"user_id": profile.UserId, "name": profile.Name},
+		"ads":             resp.Ads,
+		"total":           resp.Total,
+		"page":            resp.Page,
+		"page_size":       resp.PageSize,
+		"next_page_token": resp.NextPageToken,
+	})
+}
@@ -3,18 +3,24 @@ package main
 import (
 	"78-pflops/services/user_service/gen/proto"
 	"78-pflops/services/user_service/internal/db"
+	"78-pflops/services/user_service/internal/model"
 	"78-pflops/services/user_service/internal/repository"
 	"78-pflops/services/user_service/internal/service"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type userServiceServer struct {
@@ -129,26 +135,34 @@ func (h *httpServer) meHandler(w http.ResponseWriter, r *http.Request) {
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 			return
 		}
-		_ = json.NewEncoder(w).Encode(map[string]any{
-			"user_id": user.ID,
-			"name":    user.Name,
-			"email":   user.Email,
-		})
+		_ = json.NewEncoder(w).Encode(profileJSON(user))
 	case http.MethodPut, http.MethodPatch:
 		var req struct {
-			Name string `json:"name"`
+			Name     string        `json:"name"`
+			Contacts *contactsJSON `json:"contacts"` // заменяет контактные настройки целиком
 		}
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid JSON"})
 			return
 		}
-		if strings.TrimSpace(req.Name) == "" {
+		if strings.TrimSpace(req.Name) == "" && req.Contacts == nil {
 			w.WriteHeader(http.StatusBadRequest)
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": "name is required"})
 			return
 		}
-		if err := h.svc.UpdateProfile(ctx, userID, req.Name); err != nil {
+		var contacts *model.Contacts
+		if req.Contacts != nil {
+			contacts = &model.Contacts{Phone: req.Contacts.Phone, ShowEmail: req.Contacts.ShowEmail, PreferredChannel: req.Contacts.PreferredChannel}
+		}
+		// имя и контакты проверяются до записи и сохраняются вместе
+		err := h.svc.UpdateProfileWithContacts(ctx, userID, req.Name, contacts)
+		if errors.Is(err, service.ErrInvalidName) || errors.Is(err, service.ErrInvalidPhone) || errors.Is(err, service.ErrInvalidContactChannel) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 			return
@@ -159,11 +173,27 @@ func (h *httpServer) meHandler(w http.ResponseWriter, r *http.Request) {
 			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
 			return
 		}
-		_ = json.NewEncoder(w).Encode(map[string]any{
-			"user_id": user.ID,
-			"name":    user.Name,
-			"email":   user.Email,
-		})
+		_ = json.NewEncoder(w).Encode(profileJSON(user))
+	}
+}
+
+type contactsJSON struct {
+	Phone            string `json:"phone"`
+	ShowEmail        bool   `json:"show_email"`
+	PreferredChannel string `json:"preferred_channel"` // phone, email или пусто
+}
+
+// profileJSON — собственный профиль для /api/users/me вместе с контактными настройками.
+func profileJSON(u *model.User) map[string]any {
+	return map[string]any{
+		"user_id": u.ID,
+		"name":    u.Name,
+		"email":   u.Email,
+		"contacts": contactsJSON{
+			Phone:            u.Contacts.Phone,
+			ShowEmail:        u.Contacts.ShowEmail,
+			PreferredChannel: u.Contacts.PreferredChannel,
+		},
 	}
 }
 
@@ -193,6 +223,9 @@ func (s *userServiceServer) ValidateToken(ctx context.Context, req *proto.Valida
 
 func (s *userServiceServer) GetProfile(ctx context.Context, req *proto.GetProfileRequest) (*proto.GetProfileResponse, error) {
 	user, err := s.service.GetProfile(ctx, req.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -200,12 +233,27 @@ func (s *userServiceServer) GetProfile(ctx context.Context, req *proto.GetProfil
 }
 
 func (s *userServiceServer) UpdateProfile(ctx context.Context, req *proto.UpdateProfileRequest) (*proto.UpdateProfileResponse, error) {
-	if err := s.service.UpdateProfile(ctx, req.UserId, req.Name); err != nil {
+	var contacts *model.Contacts
+	if c := req.Contacts; c != nil {
+		contacts = &model.Contacts{Phone: c.Phone, ShowEmail: c.ShowEmail, PreferredChannel: c.PreferredChannel}
+	}
+	if err := s.service.UpdateProfileWithContacts(ctx, req.UserId, req.Name, contacts); err != nil {
 		return &proto.UpdateProfileResponse{Success: false, Message: err.Error()}, nil
 	}
 	return &proto.UpdateProfileResponse{Success: true, Message: "profile updated"}, nil
 }
 
+func (s *userServiceServer) GetContacts(ctx context.Context, req *proto.GetContactsRequest) (*proto.GetContactsResponse, error) {
+	c, err := s.service.GetContacts(ctx, req.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetContactsResponse{UserId: c.UserID, Name: c.Name, Phone: c.Phone, Email: c.Email, PreferredChannel: c.PreferredChannel}, nil
+}
+
 func (s *userServiceServer) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
 	// В упрощённом варианте пароль не проверяем, только наличие токена/ID
 	if err := s.service.DeleteUser(ctx, req.UserId); err != nil {
@@ -215,8 +263,16 @@ func (s *userServiceServer) DeleteUser(ctx context.Context, req *proto.DeleteUse
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
 	// shared service layer
 	conn := db.Connect()
+	migrateOnStart(conn)
 	repo := repository.NewUserRepository(conn)
 	svc := service.NewUserService(repo)
 
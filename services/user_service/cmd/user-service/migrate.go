@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"78-pflops/services/user_service/internal/db"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const migrateUsage = "usage: user-service migrate up | down [N] | status | baseline VERSION"
+
+// migrateOnStart применяет миграции при старте сервиса, если MIGRATE_ON_START не равен "false".
+func migrateOnStart(pool *pgxpool.Pool) {
+	if os.Getenv("MIGRATE_ON_START") == "false" {
+		return
+	}
+	m, err := db.NewMigrator(pool)
+	if err != nil {
+		log.Fatalf("migrations: %v", err)
+	}
+	applied, err := m.Up(context.Background())
+	for _, mig := range applied {
+		log.Printf("migrations: applied %s", mig)
+	}
+	if err != nil {
+		log.Fatalf("migrations: %v", err)
+	}
+}
+
+// runMigrate выполняет подкоманду migrate.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	pool := db.Connect()
+	defer pool.Close()
+	m, err := db.NewMigrator(pool)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		printMigrations("applied", applied, err)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return errors.New("down: N must be a positive number")
+			}
+		}
+		reverted, err := m.Down(ctx, steps)
+		printMigrations("rolled back", reverted, err)
+		return err
+	case "baseline":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("baseline: bad version %q", args[1])
+		}
+		marked, err := m.Baseline(ctx, version)
+		printMigrations("marked as applied", marked, err)
+		return err
+	case "status":
+		states, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, st := range states {
+			state := "pending"
+			if st.Applied {
+				state = "applied " + st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if st.Modified {
+				state += " (modified since applied)"
+			}
+			fmt.Fprintf(w, "%s\t%s\n", st.Migration, state)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+func printMigrations(verb string, migrations []db.Migration, err error) {
+	if len(migrations) == 0 && err == nil {
+		fmt.Println("nothing to do")
+		return
+	}
+	for _, mig := range migrations {
+		fmt.Printf("%s %s\n", verb, mig)
+	}
+}
@@ -0,0 +1,5 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_preferred_contact;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS preferred_contact,
+    DROP COLUMN IF EXISTS show_email,
+    DROP COLUMN IF EXISTS phone;
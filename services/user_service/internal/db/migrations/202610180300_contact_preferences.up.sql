@@ -0,0 +1,10 @@
+-- Seller contact preferences. Phone is stored normalized (+79991234567), '' means not set;
+-- email is shown to buyers only when show_email is true.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS phone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS show_email BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS preferred_contact TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_preferred_contact;
+ALTER TABLE users ADD CONSTRAINT chk_users_preferred_contact
+    CHECK (preferred_contact IN ('', 'phone', 'email'));
@@ -5,4 +5,27 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Name         string
+	Contacts     Contacts
+}
+
+// Способы связи, которые продавец может указать как предпочтительные.
+const (
+	ContactPhone = "phone"
+	ContactEmail = "email"
+)
+
+// Contacts — контактные настройки продавца.
+type Contacts struct {
+	Phone            string // пусто — телефон не указан
+	ShowEmail        bool   // показывать email покупателям
+	PreferredChannel string // ContactPhone, ContactEmail или пусто
+}
+
+// SellerContacts — то, что покупатель видит после запроса контактов.
+type SellerContacts struct {
+	UserID           string
+	Name             string
+	Phone            string
+	Email            string // пусто, если продавец скрыл email
+	PreferredChannel string
 }
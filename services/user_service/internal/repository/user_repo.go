@@ -26,9 +26,9 @@ func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
 
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
 	row := r.db.QueryRow(ctx,
-		`SELECT id, email, password_hash, name FROM users WHERE email = $1`, email)
+		`SELECT id, email, password_hash, name, phone, show_email, preferred_contact FROM users WHERE email = $1`, email)
 	var u model.User
-	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Name); err != nil {
+	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Name, &u.Contacts.Phone, &u.Contacts.ShowEmail, &u.Contacts.PreferredChannel); err != nil {
 		return nil, err
 	}
 	return &u, nil
@@ -36,9 +36,9 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*model.U
 
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*model.User, error) {
 	row := r.db.QueryRow(ctx,
-		`SELECT id, email, password_hash, name FROM users WHERE id = $1`, id)
+		`SELECT id, email, password_hash, name, phone, show_email, preferred_contact FROM users WHERE id = $1`, id)
 	var u model.User
-	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Name); err != nil {
+	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Name, &u.Contacts.Phone, &u.Contacts.ShowEmail, &u.Contacts.PreferredChannel); err != nil {
 		return nil, err
 	}
 	return &u, nil
@@ -56,6 +56,28 @@ func (r *UserRepository) UpdateName(ctx context.Context, id, name string) error
 	return nil
 }
 
+// UpdateProfile changes the name and contact preferences in one statement; nil leaves a field as is.
+func (r *UserRepository) UpdateProfile(ctx context.Context, id string, name *string, c *model.Contacts) error {
+	var contacts model.Contacts
+	if c != nil {
+		contacts = *c
+	}
+	result, err := r.db.Exec(ctx,
+		`UPDATE users SET name = COALESCE($2, name),
+			phone = CASE WHEN $3 THEN $4 ELSE phone END,
+			show_email = CASE WHEN $3 THEN $5 ELSE show_email END,
+			preferred_contact = CASE WHEN $3 THEN $6 ELSE preferred_contact END
+		WHERE id = $1`,
+		id, name, c != nil, contacts.Phone, contacts.ShowEmail, contacts.PreferredChannel)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	result, err := r.db.Exec(ctx,
 		`DELETE FROM users WHERE id = $1`, id)
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
+	"unicode/utf8"
 
 	"78-pflops/services/user_service/internal/model"
 	"78-pflops/services/user_service/internal/utils"
@@ -16,9 +18,19 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
 	GetByID(ctx context.Context, id string) (*model.User, error)
 	UpdateName(ctx context.Context, id, name string) error
+	UpdateProfile(ctx context.Context, id string, name *string, c *model.Contacts) error
 	Delete(ctx context.Context, id string) error
 }
 
+var (
+	ErrInvalidName           = errors.New("name must be at most 100 characters")
+	ErrInvalidPhone          = errors.New("invalid phone number")
+	ErrInvalidContactChannel = errors.New("preferred contact channel must be phone or email and available to buyers")
+)
+
+// maxNameLength — предел длины имени в символах.
+const maxNameLength = 100
+
 type UserService struct {
 	repo UserRepository
 }
@@ -110,6 +122,71 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID, name string) er
 	return s.repo.UpdateName(ctx, userID, name)
 }
 
+// UpdateProfileWithContacts меняет имя и, если c не nil, контактные настройки одной записью:
+// при ошибке проверки не меняется ничего. Пустое имя оставляет прежнее. Предпочтительный
+// способ связи должен быть доступен покупателю: телефон указан или email открыт.
+func (s *UserService) UpdateProfileWithContacts(ctx context.Context, userID, name string, c *model.Contacts) error {
+	if strings.TrimSpace(name) == "" && c == nil {
+		return errors.New("name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return ErrInvalidName
+	}
+	var namePtr *string
+	if strings.TrimSpace(name) != "" {
+		namePtr = &name
+	}
+	if c != nil {
+		normalized, err := normalizeContacts(*c)
+		if err != nil {
+			return err
+		}
+		c = &normalized
+	}
+	return s.repo.UpdateProfile(ctx, userID, namePtr, c)
+}
+
+// normalizeContacts приводит телефон к E.164 и проверяет, что предпочтительный способ связи доступен.
+func normalizeContacts(c model.Contacts) (model.Contacts, error) {
+	if strings.TrimSpace(c.Phone) != "" {
+		phone, ok := utils.NormalizePhone(c.Phone)
+		if !ok {
+			return model.Contacts{}, ErrInvalidPhone
+		}
+		c.Phone = phone
+	} else {
+		c.Phone = ""
+	}
+	c.PreferredChannel = strings.ToLower(strings.TrimSpace(c.PreferredChannel))
+	switch c.PreferredChannel {
+	case "":
+	case model.ContactPhone:
+		if c.Phone == "" {
+			return model.Contacts{}, ErrInvalidContactChannel
+		}
+	case model.ContactEmail:
+		if !c.ShowEmail {
+			return model.Contacts{}, ErrInvalidContactChannel
+		}
+	default:
+		return model.Contacts{}, ErrInvalidContactChannel
+	}
+	return c, nil
+}
+
+// GetContacts возвращает контакты продавца для покупателя: email — только если продавец его открыл.
+func (s *UserService) GetContacts(ctx context.Context, userID string) (*model.SellerContacts, error) {
+	u, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	c := &model.SellerContacts{UserID: u.ID, Name: u.Name, Phone: u.Contacts.Phone, PreferredChannel: u.Contacts.PreferredChannel}
+	if u.Contacts.ShowEmail {
+		c.Email = u.Email
+	}
+	return c, nil
+}
+
 // DeleteUser удаляет пользователя по ID.
 func (s *UserService) DeleteUser(ctx context.Context, userID string) error {
 	return s.repo.Delete(ctx, userID)
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -61,6 +62,20 @@ func (r *fakeRepo) UpdateName(ctx context.Context, id, name string) error {
 	return nil
 }
 
+func (r *fakeRepo) UpdateProfile(ctx context.Context, id string, name *string, c *model.Contacts) error {
+	u, ok := r.byID[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	if name != nil {
+		u.Name = *name
+	}
+	if c != nil {
+		u.Contacts = *c
+	}
+	return nil
+}
+
 func (r *fakeRepo) Delete(ctx context.Context, id string) error {
 	u, ok := r.byID[id]
 	if !ok {
@@ -254,3 +269,97 @@ func TestGetProfile_UpdateProfile_DeleteUser(t *testing.T) {
 		t.Fatalf("expected ErrNoRows after delete, got %v", err)
 	}
 }
+
+func TestUpdateProfileWithContacts_GetContacts(t *testing.T) {
+	svc := setupService()
+	id, _, err := svc.Register(context.Background(), "seller@example.com", "ValidPass!", "Seller")
+	if err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	if err := svc.UpdateProfileWithContacts(context.Background(), id, "", &model.Contacts{Phone: "8 (999) 123-45-67", PreferredChannel: " Phone "}); err != nil {
+		t.Fatalf("UpdateProfileWithContacts failed: %v", err)
+	}
+	u, _ := svc.GetProfile(context.Background(), id)
+	if u.Contacts.Phone != "+79991234567" || u.Contacts.PreferredChannel != model.ContactPhone {
+		t.Fatalf("expected normalized contacts, got %+v", u.Contacts)
+	}
+	// email скрыт, пока продавец его не откроет
+	revealed, err := svc.GetContacts(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetContacts failed: %v", err)
+	}
+	if revealed.Phone != "+79991234567" || revealed.Email != "" || revealed.Name != "Seller" {
+		t.Fatalf("unexpected contacts %+v", revealed)
+	}
+	if err := svc.UpdateProfileWithContacts(context.Background(), id, "", &model.Contacts{ShowEmail: true, PreferredChannel: model.ContactEmail}); err != nil {
+		t.Fatalf("UpdateProfileWithContacts failed: %v", err)
+	}
+	if revealed, _ := svc.GetContacts(context.Background(), id); revealed.Email != "seller@example.com" || revealed.Phone != "" {
+		t.Fatalf("expected email only, got %+v", revealed)
+	}
+
+	invalid := map[string]struct {
+		c    model.Contacts
+		want error
+	}{
+		"short phone":         {model.Contacts{Phone: "12345"}, ErrInvalidPhone},
+		"letters in phone":    {model.Contacts{Phone: "+7999abc4567"}, ErrInvalidPhone},
+		"phone without phone": {model.Contacts{ShowEmail: true, PreferredChannel: model.ContactPhone}, ErrInvalidContactChannel},
+		"hidden email":        {model.Contacts{Phone: "+79991234567", PreferredChannel: model.ContactEmail}, ErrInvalidContactChannel},
+		"unknown channel":     {model.Contacts{Phone: "+79991234567", PreferredChannel: "pigeon"}, ErrInvalidContactChannel},
+	}
+	for name, tc := range invalid {
+		if err := svc.UpdateProfileWithContacts(context.Background(), id, "", &tc.c); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+	if revealed, _ := svc.GetContacts(context.Background(), id); revealed.Email != "seller@example.com" {
+		t.Errorf("rejected contacts must not be saved, got %+v", revealed)
+	}
+}
+
+func TestUpdateProfileWithContacts_AllOrNothing(t *testing.T) {
+	svc := setupService()
+	id, _, err := svc.Register(context.Background(), "me@example.com", "ValidPass!", "Old")
+	if err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	// неверный телефон: имя тоже не меняется
+	err = svc.UpdateProfileWithContacts(context.Background(), id, "New", &model.Contacts{Phone: "12"})
+	if !errors.Is(err, ErrInvalidPhone) {
+		t.Fatalf("expected ErrInvalidPhone, got %v", err)
+	}
+	u, _ := svc.GetProfile(context.Background(), id)
+	if u.Name != "Old" {
+		t.Errorf("name must stay unchanged on invalid contacts, got %q", u.Name)
+	}
+
+	if err := svc.UpdateProfileWithContacts(context.Background(), id, "New", &model.Contacts{Phone: "+7 999 123-45-67", PreferredChannel: "phone"}); err != nil {
+		t.Fatalf("UpdateProfileWithContacts failed: %v", err)
+	}
+	u, _ = svc.GetProfile(context.Background(), id)
+	if u.Name != "New" || u.Contacts.Phone != "+79991234567" {
+		t.Errorf("expected name and normalized phone saved, got %+v", u)
+	}
+
+	// пустое имя сохраняет прежнее
+	if err := svc.UpdateProfileWithContacts(context.Background(), id, "", &model.Contacts{}); err != nil {
+		t.Fatalf("UpdateProfileWithContacts failed: %v", err)
+	}
+	u, _ = svc.GetProfile(context.Background(), id)
+	if u.Name != "New" || u.Contacts.Phone != "" {
+		t.Errorf("expected name kept and contacts cleared, got %+v", u)
+	}
+
+	// слишком длинное имя: контакты тоже не меняются
+	err = svc.UpdateProfileWithContacts(context.Background(), id, strings.Repeat("я", maxNameLength+1), &model.Contacts{ShowEmail: true, PreferredChannel: model.ContactEmail})
+	if !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+	u, _ = svc.GetProfile(context.Background(), id)
+	if u.Name != "New" || u.Contacts.ShowEmail || u.Contacts.PreferredChannel != "" {
+		t.Errorf("contacts must stay unchanged on invalid name, got %+v", u)
+	}
+}
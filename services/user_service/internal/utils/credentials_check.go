@@ -1,6 +1,11 @@
 package utils
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
+
+var phoneRe = regexp.MustCompile(`^\+[1-9][0-9]{9,14}$`)
 
 func IsValidEmail(email string) bool {
 	// Simple email validation logic
@@ -11,3 +16,13 @@ func IsValidPassword(password string) bool {
 	// Simple password validation logic
 	return len(password) >= 8 && strings.ContainsAny(password, "!@#$%^&*()_+")
 }
+
+// NormalizePhone приводит номер к международному виду +79991234567: убирает пробелы, дефисы
+// и скобки, российский префикс 8 заменяет на +7.
+func NormalizePhone(phone string) (string, bool) {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(strings.TrimSpace(phone))
+	if len(phone) == 11 && strings.HasPrefix(phone, "8") {
+		phone = "+7" + phone[1:]
+	}
+	return phone, phoneRe.MatchString(phone)
+}